@@ -0,0 +1,125 @@
+package xlsx_utilities
+
+import (
+	"reflect"
+	"strings"
+)
+
+// WithColumnRoles restricts columns to the given roles, keyed by header name. A key also covers
+// the flattened headers of a nested struct, e.g. "Salary" covers "Salary Base" and "Salary Bonus".
+// Columns without roles are exported to everyone; restricted columns are only exported when the
+// viewer set with WithViewer holds one of their roles. The map overrides the roles tag option of the fields.
+func WithColumnRoles(roles map[string][]string) Option {
+	return func(c *config) {
+		if c.columnRoles == nil {
+			c.columnRoles = make(map[string][]string, len(roles))
+		}
+		for header, allowed := range roles {
+			c.columnRoles[header] = append(c.columnRoles[header], allowed...)
+		}
+	}
+}
+
+// WithViewer sets the role the export is produced for, so one dataset can produce
+// e.g. HR, manager and employee variants of the same file
+func WithViewer(role string) Option {
+	return func(c *config) {
+		c.viewer = role
+	}
+}
+
+// columnAllowed reports whether the configured viewer may see the column with the given header.
+// tagged holds the roles of the column from the roles tag option, used when WithColumnRoles has none.
+func (c *config) columnAllowed(header string, tagged []string) bool {
+	restricted := false
+	for key, allowed := range c.columnRoles {
+		if header != key && !strings.HasPrefix(header, key+" ") {
+			continue
+		}
+		restricted = true
+		for _, role := range allowed {
+			if role == c.viewer {
+				return true
+			}
+		}
+	}
+	if restricted {
+		return false
+	}
+
+	for _, role := range tagged {
+		if role == c.viewer {
+			return true
+		}
+	}
+	return len(tagged) == 0
+}
+
+// fieldRoles returns the roles of the column with the given header in struct type t from the roles tag option,
+// e.g. `xlsx:"Salary,roles=hr;manager"`. The option on a nested struct field covers all of its columns.
+func fieldRoles(t reflect.Type, header string) []string {
+	plan := planField(t, header)
+	if plan.err != nil {
+		return nil
+	}
+
+	var roles []string
+	for _, index := range plan.path {
+		for t.Kind() == reflect.Ptr || t.Kind() == reflect.Slice {
+			t = t.Elem()
+		}
+		field := t.FieldByIndex(index)
+		if option, ok := tagOption(field, "roles"); ok {
+			for _, role := range strings.Split(option, ";") {
+				if role != "" {
+					roles = append(roles, role)
+				}
+			}
+		}
+		t = field.Type
+	}
+	return roles
+}
+
+// forViewer returns the data limited to the columns the configured viewer may see
+func (ed *ExcelData[T]) forViewer(cfg *config) *ExcelData[T] {
+	t := reflect.TypeOf((*T)(nil)).Elem()
+
+	var keep []int
+	for i, header := range ed.Headers {
+		if cfg.columnAllowed(header, fieldRoles(t, header)) {
+			keep = append(keep, i)
+		}
+	}
+	if len(keep) == len(ed.Headers) {
+		return ed
+	}
+
+	headers := make([]string, len(keep))
+	for i, col := range keep {
+		headers[i] = ed.Headers[col]
+	}
+
+	rows := make([][]interface{}, len(ed.Rows))
+	for r, row := range ed.Rows {
+		rows[r] = make([]interface{}, 0, len(keep))
+		for _, col := range keep {
+			if col < len(row) {
+				rows[r] = append(rows[r], row[col])
+			}
+		}
+	}
+
+	filtered := ed.view(rows)
+	filtered.Headers = headers
+	filtered.lines = ed.lines
+	if len(ed.Provenance) > 0 {
+		filtered.Provenance = make([][]Provenance, len(ed.Rows))
+		for r := range ed.Rows {
+			for _, col := range keep {
+				filtered.Provenance[r] = append(filtered.Provenance[r], ed.provenanceAt(r, col))
+			}
+		}
+	}
+	return filtered
+}
@@ -0,0 +1,77 @@
+package xlsx_utilities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestColumnRoles(t *testing.T) {
+	type salary struct {
+		Base  int
+		Bonus int
+	}
+
+	type employee struct {
+		Name   string
+		Rating int
+		Salary salary
+	}
+
+	data := []employee{{Name: "Alice", Rating: 4, Salary: salary{Base: 100, Bonus: 10}}}
+	excelData, err := FromStruct(data)
+	assert.NoError(t, err)
+
+	excelData.WithOptions(WithColumnRoles(map[string][]string{
+		"Rating": {"hr", "manager"},
+		"Salary": {"hr"},
+	}))
+
+	headersFor := func(opts ...Option) []string {
+		f, err := excelData.ToWorkbook(opts...)
+		assert.NoError(t, err)
+		defer f.Close()
+
+		rows, err := f.GetRows("Sheet1")
+		assert.NoError(t, err)
+		return rows[0]
+	}
+
+	assert.Equal(t, []string{"Name", "Rating", "Salary Base", "Salary Bonus"}, headersFor(WithViewer("hr")))
+	assert.Equal(t, []string{"Name", "Rating"}, headersFor(WithViewer("manager")))
+	assert.Equal(t, []string{"Name"}, headersFor(WithViewer("employee")))
+	assert.Equal(t, []string{"Name"}, headersFor())
+
+	// The stored data is not modified by filtered exports
+	assert.Len(t, excelData.Headers, 4)
+
+	t.Run("Roles tag option", func(t *testing.T) {
+		type tagged struct {
+			Name   string
+			Rating int    `xlsx:"Rating,roles=hr;manager"`
+			Salary salary `xlsx:",roles=hr"`
+		}
+
+		excelData, err := FromStruct([]tagged{{Name: "Alice", Rating: 4, Salary: salary{Base: 100, Bonus: 10}}})
+		assert.NoError(t, err)
+
+		headersFor := func(opts ...Option) []string {
+			f, err := excelData.ToWorkbook(opts...)
+			assert.NoError(t, err)
+			defer f.Close()
+
+			rows, err := f.GetRows("Sheet1")
+			assert.NoError(t, err)
+			return rows[0]
+		}
+
+		assert.Equal(t, []string{"Name", "Rating", "Salary Base", "Salary Bonus"}, headersFor(WithViewer("hr")))
+		assert.Equal(t, []string{"Name", "Rating"}, headersFor(WithViewer("manager")))
+		assert.Equal(t, []string{"Name"}, headersFor())
+
+		// the map overrides the tag
+		override := WithColumnRoles(map[string][]string{"Salary": {"manager"}})
+		assert.Equal(t, []string{"Name", "Rating", "Salary Base", "Salary Bonus"}, headersFor(WithViewer("manager"), override))
+		assert.Equal(t, []string{"Name", "Rating"}, headersFor(WithViewer("hr"), override))
+	})
+}
@@ -0,0 +1,103 @@
+package xlsx_utilities
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// invalidCellColor is the fill of the cells highlighted by HighlightErrors
+const invalidCellColor = "FFC7CE"
+
+// AnnotateErrors writes a copy of the uploaded workbook src to dst with the cells of the import errors
+// highlighted in red and a comment describing each problem, to echo a failed import back to its sender.
+// The sheet read is selected with the same options as the import.
+func AnnotateErrors(src, dst string, errs []ImportError, opts ...Option) error {
+	f, err := excelize.OpenFile(src)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	if err := HighlightErrors(f, errs, opts...); err != nil {
+		return err
+	}
+	return saveWorkbook(f, dst, newConfig(opts))
+}
+
+// HighlightErrors fills the cells of the import errors red on the sheet of the opened workbook,
+// keeping their other formatting, and comments them with the error messages. A comment already on
+// the cell, such as one of the sender or of an earlier call, is kept and the new messages are added
+// below it. Errors of a whole row, such as those of a WithAfterReadRow hook, have no cell and are left out.
+func HighlightErrors(f *excelize.File, errs []ImportError, opts ...Option) error {
+	cfg, err := selectSheet(f, newConfig(opts))
+	if err != nil {
+		return err
+	}
+
+	var cells []string
+	messages := make(map[string][]string)
+	for _, e := range errs {
+		if e.Cell == "" {
+			continue
+		}
+		if _, ok := messages[e.Cell]; !ok {
+			cells = append(cells, e.Cell)
+		}
+		messages[e.Cell] = append(messages[e.Cell], e.message())
+	}
+
+	existing, err := f.GetComments(cfg.sheet)
+	if err != nil {
+		return err
+	}
+	previous := make(map[string]excelize.Comment)
+	for _, comment := range existing {
+		previous[comment.Cell] = comment
+	}
+
+	highlight := newRestyler(f, cfg.sheet, func(s *excelize.Style) {
+		s.Fill = excelize.Fill{Type: "pattern", Pattern: 1, Color: []string{invalidCellColor}}
+	})
+	for _, cell := range cells {
+		if err := highlight.restyle(cell); err != nil {
+			return err
+		}
+		comment := excelize.Comment{Cell: cell, Author: "Import"}
+		lines := messages[cell]
+		if old, ok := previous[cell]; ok {
+			// excelize adds a second comment to the cell instead of replacing the first
+			if err := f.DeleteComment(cfg.sheet, cell); err != nil {
+				return err
+			}
+			comment.Author = old.Author
+			lines = mergeCommentLines(commentText(old), lines)
+		}
+		comment.Text = strings.Join(lines, "\n")
+		if err := f.AddComment(cfg.sheet, comment); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// commentText returns the plain text of a comment, joining its rich text runs
+func commentText(comment excelize.Comment) string {
+	text := comment.Text
+	for _, run := range comment.Paragraph {
+		text += run.Text
+	}
+	return text
+}
+
+// mergeCommentLines returns the lines of the existing comment text followed by the messages it does not hold yet
+func mergeCommentLines(text string, messages []string) []string {
+	lines := strings.Split(text, "\n")
+	for _, message := range messages {
+		if !slices.Contains(lines, message) {
+			lines = append(lines, message)
+		}
+	}
+	return lines
+}
@@ -0,0 +1,100 @@
+package xlsx_utilities
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/xuri/excelize/v2"
+)
+
+func TestAnnotateErrors(t *testing.T) {
+	type shipment struct {
+		Name string
+		Due  time.Time
+	}
+	src, dst := "test_annotate_src.xlsx", "test_annotate_dst.xlsx"
+	defer os.Remove(src)
+	defer os.Remove(dst)
+
+	f := excelize.NewFile()
+	assert.NoError(t, f.SetSheetName("Sheet1", "Upload"))
+	assert.NoError(t, f.SetSheetRow("Upload", "A1", &[]interface{}{"Name", "Due"}))
+	assert.NoError(t, f.SetSheetRow("Upload", "A2", &[]interface{}{"Alice", "2024-05-01"}))
+	assert.NoError(t, f.SetSheetRow("Upload", "A3", &[]interface{}{"Bob", "soon"}))
+	assert.NoError(t, f.SaveAs(src))
+	f.Close()
+
+	excelData, err := FromExcel[shipment](src, WithSheet("Upload"))
+	assert.NoError(t, err)
+	result := excelData.ToStruct()
+	assert.Len(t, result.Errors, 1)
+
+	assert.NoError(t, AnnotateErrors(src, dst, result.Errors, WithSheet("Upload")))
+
+	f, err = excelize.OpenFile(dst)
+	assert.NoError(t, err)
+	defer f.Close()
+
+	comments, err := f.GetComments("Upload")
+	assert.NoError(t, err)
+	assert.Len(t, comments, 1)
+	assert.Equal(t, "B3", comments[0].Cell)
+	assert.Contains(t, comments[0].Text, "soon")
+
+	id, err := f.GetCellStyle("Upload", "B3")
+	assert.NoError(t, err)
+	style, err := f.GetStyle(id)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{invalidCellColor}, style.Fill.Color)
+
+	id, err = f.GetCellStyle("Upload", "B2")
+	assert.NoError(t, err)
+	assert.Equal(t, 0, id)
+
+	t.Run("Skipped rows", func(t *testing.T) {
+		src, dst := "test_annotate_skipped_src.xlsx", "test_annotate_skipped_dst.xlsx"
+		defer os.Remove(src)
+		defer os.Remove(dst)
+
+		f := excelize.NewFile()
+		assert.NoError(t, f.SetSheetRow("Sheet1", "A1", &[]interface{}{"Name", "Due"}))
+		assert.NoError(t, f.SetSheetRow("Sheet1", "A3", &[]interface{}{"Alice", "2024-05-01"}))
+		assert.NoError(t, f.SetSheetRow("Sheet1", "A5", &[]interface{}{"Bob", "soon"}))
+		assert.NoError(t, f.SaveAs(src))
+		f.Close()
+
+		excelData, err := FromExcel[shipment](src, WithSkipBlankRows())
+		assert.NoError(t, err)
+		result := excelData.ToStruct()
+		assert.Len(t, result.Errors, 1)
+
+		assert.NoError(t, AnnotateErrors(src, dst, result.Errors, WithSkipBlankRows()))
+
+		f, err = excelize.OpenFile(dst)
+		assert.NoError(t, err)
+		defer f.Close()
+
+		comments, err := f.GetComments("Sheet1")
+		assert.NoError(t, err)
+		assert.Len(t, comments, 1)
+		assert.Equal(t, "B5", comments[0].Cell)
+	})
+
+	t.Run("Existing comments", func(t *testing.T) {
+		f, err := excelize.OpenFile(src)
+		assert.NoError(t, err)
+		defer f.Close()
+		assert.NoError(t, f.AddComment("Upload", excelize.Comment{Cell: "B3", Author: "Sender", Text: "date to be confirmed"}))
+
+		assert.NoError(t, HighlightErrors(f, result.Errors, WithSheet("Upload")))
+		assert.NoError(t, HighlightErrors(f, result.Errors, WithSheet("Upload")))
+
+		comments, err := f.GetComments("Upload")
+		assert.NoError(t, err)
+		assert.Len(t, comments, 1)
+		assert.Equal(t, "Sender", comments[0].Author)
+		assert.Equal(t, "date to be confirmed\n"+result.Errors[0].message(), comments[0].Text)
+	})
+}
@@ -0,0 +1,90 @@
+package xlsx_utilities
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// WithFsync makes saves flush the written file to disk before it replaces the target,
+// so a power loss right after a save cannot leave an empty or partial report
+func WithFsync() Option {
+	return func(c *config) {
+		c.fsync = true
+	}
+}
+
+// writeFileAtomic writes a temporary file next to filename and renames it over the target,
+// so readers and crashes see either the previous file or the complete new one
+func writeFileAtomic(filename string, fsync bool, write func(w io.Writer) error) error {
+	tmp, err := os.CreateTemp(filepath.Dir(filename), "."+filepath.Base(filename)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if err := write(tmp); err != nil {
+		tmp.Close()
+		return err
+	}
+	if fsync {
+		if err := tmp.Sync(); err != nil {
+			tmp.Close()
+			return err
+		}
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	// CreateTemp makes the file readable by the owner only; keep the permissions of the file replaced
+	mode := os.FileMode(0o644)
+	if info, err := os.Stat(filename); err == nil {
+		mode = info.Mode().Perm()
+	}
+	if err := os.Chmod(tmp.Name(), mode); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), filename)
+}
+
+// saveWorkbook writes the workbook to filename atomically
+func saveWorkbook(f *excelize.File, filename string, cfg *config) error {
+	if len(filename) > excelize.MaxFilePathLength {
+		return excelize.ErrMaxFilePathLength
+	}
+	// the extension decides the content type, e.g. of macro-enabled workbooks
+	f.Path = filename
+
+	return writeFileAtomic(filename, cfg.fsync, func(w io.Writer) error {
+		_, err := f.WriteTo(w)
+		return err
+	})
+}
+
+// SaveWithBackup saves the Excel file like Save, first copying the file it replaces to filename + ".bak"
+func (ed *ExcelData[T]) SaveWithBackup(filename string, opts ...Option) error {
+	if err := backupFile(filename, filename+".bak"); err != nil {
+		return err
+	}
+	return ed.Save(filename, opts...)
+}
+
+// backupFile copies filename to backup, replacing an earlier backup. A missing filename is not an error.
+func backupFile(filename, backup string) error {
+	src, err := os.Open(filename)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+
+	return writeFileAtomic(backup, false, func(w io.Writer) error {
+		_, err := io.Copy(w, src)
+		return err
+	})
+}
@@ -0,0 +1,52 @@
+package xlsx_utilities
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAtomicSave(t *testing.T) {
+	dir := t.TempDir()
+	filename := filepath.Join(dir, "report.xlsx")
+
+	first, err := FromStruct([]person{{Name: "Alice", Age: 30}})
+	assert.NoError(t, err)
+	second, err := FromStruct([]person{{Name: "Bob", Age: 25}})
+	assert.NoError(t, err)
+
+	assert.NoError(t, first.Save(filename, WithFsync()))
+
+	t.Run("Backup of the previous version", func(t *testing.T) {
+		assert.NoError(t, second.SaveWithBackup(filename))
+
+		current, err := FromExcel[person](filename)
+		assert.NoError(t, err)
+		assert.Equal(t, "Bob", current.Rows[0][0])
+
+		previous, err := FromExcel[person](filename + ".bak")
+		assert.NoError(t, err)
+		assert.Equal(t, "Alice", previous.Rows[0][0])
+	})
+
+	t.Run("Failed save keeps the file", func(t *testing.T) {
+		target := filepath.Join(dir, "report.txt")
+		assert.NoError(t, os.WriteFile(target, []byte("previous"), 0o644))
+
+		assert.Error(t, first.Save(target))
+
+		content, err := os.ReadFile(target)
+		assert.NoError(t, err)
+		assert.Equal(t, "previous", string(content))
+	})
+
+	entries, err := os.ReadDir(dir)
+	assert.NoError(t, err)
+	var names []string
+	for _, entry := range entries {
+		names = append(names, entry.Name())
+	}
+	assert.ElementsMatch(t, []string{"report.xlsx", "report.xlsx.bak", "report.txt"}, names)
+}
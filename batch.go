@@ -0,0 +1,53 @@
+package xlsx_utilities
+
+import "fmt"
+
+// ToStructBatches converts the rows and calls fn with every size converted records, together with the import errors
+// of the rows converted since the previous call. The last call holds the remaining records and errors.
+// Conversion stops at the first error returned by fn, which is returned.
+// Use ToStructBatchResults to receive the warnings as well.
+func (ed *ExcelData[T]) ToStructBatches(size int, fn func(batch []T, errs []ImportError) error) error {
+	return ed.ToStructBatchResults(size, func(batch ImportResult[T]) error {
+		return fn(batch.Data, batch.Errors)
+	})
+}
+
+// ToStructBatchResults is ToStructBatches delivering each batch as an ImportResult, with the cell warnings and
+// sheet rows of the records converted since the previous call. The header warnings concern the whole sheet and
+// are delivered with the first batch only.
+func (ed *ExcelData[T]) ToStructBatchResults(size int, fn func(batch ImportResult[T]) error) error {
+	if size <= 0 {
+		return fmt.Errorf("invalid batch size %d", size)
+	}
+
+	conv := ed.newRowConverter(ed.config())
+
+	next := func() ImportResult[T] {
+		return ImportResult[T]{Data: make([]T, 0, size), Sheet: ed.sheet}
+	}
+	batch := next()
+	batch.HeaderWarnings = conv.headerWarnings
+	pending := func() bool {
+		return len(batch.Data) > 0 || len(batch.Errors) > 0 || len(batch.HeaderWarnings) > 0 || len(batch.CellWarnings) > 0
+	}
+
+	var err error
+	conv.convertRows(ed.Rows, func(row convertedRow[T]) bool {
+		batch.add(row)
+		if len(batch.Data) == size {
+			if err = fn(batch); err != nil {
+				return false
+			}
+			batch = next()
+		}
+		return true
+	})
+	if err != nil {
+		return err
+	}
+
+	if pending() {
+		return fn(batch)
+	}
+	return nil
+}
@@ -0,0 +1,74 @@
+package xlsx_utilities
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToStructBatches(t *testing.T) {
+	excelData := NewExcelData[person]([]string{"Name", "Age"})
+	for i := 1; i <= 7; i++ {
+		excelData.AddRow([]interface{}{fmt.Sprintf("P%d", i), i})
+	}
+	excelData.WithOptions(WithAfterReadRow(func(rowIndex int, item *person) error {
+		if item.Age == 2 {
+			return assert.AnError
+		}
+		return nil
+	}))
+
+	t.Run("Fixed size batches", func(t *testing.T) {
+		var sizes []int
+		var errorRows []int
+		err := excelData.ToStructBatches(3, func(batch []person, errs []ImportError) error {
+			sizes = append(sizes, len(batch))
+			for _, e := range errs {
+				errorRows = append(errorRows, e.RowIndex)
+			}
+			return nil
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, []int{3, 3}, sizes)
+		assert.Equal(t, []int{3}, errorRows)
+	})
+
+	t.Run("Callback error stops", func(t *testing.T) {
+		calls := 0
+		stop := errors.New("stop")
+		err := excelData.ToStructBatches(2, func(batch []person, errs []ImportError) error {
+			calls++
+			return stop
+		})
+		assert.Equal(t, stop, err)
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("Invalid size", func(t *testing.T) {
+		err := excelData.ToStructBatches(0, func([]person, []ImportError) error { return nil })
+		assert.EqualError(t, err, "invalid batch size 0")
+	})
+
+	t.Run("Batch results", func(t *testing.T) {
+		excelData := NewExcelData[person]([]string{"Name", "Age", "Notes"}).WithOptions(WithHeaderEvolution())
+		excelData.AddRow([]interface{}{"P1", " 1 ", ""})
+		excelData.AddRow([]interface{}{"P2", "2", ""})
+		excelData.AddRow([]interface{}{"P3", " 3 ", ""})
+
+		var batches []ImportResult[person]
+		err := excelData.ToStructBatchResults(2, func(batch ImportResult[person]) error {
+			batches = append(batches, batch)
+			return nil
+		})
+		assert.NoError(t, err)
+		assert.Len(t, batches, 2)
+		assert.Equal(t, []string{"column 'Notes' has no matching field and is ignored"}, batches[0].HeaderWarnings)
+		assert.Empty(t, batches[1].HeaderWarnings)
+		assert.Equal(t, []int{2, 3}, batches[0].RowNumbers)
+		assert.Equal(t, []int{4}, batches[1].RowNumbers)
+		assert.Len(t, batches[0].CellWarnings, 1)
+		assert.Equal(t, "B4", batches[1].CellWarnings[0].Cell)
+	})
+}
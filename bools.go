@@ -0,0 +1,54 @@
+package xlsx_utilities
+
+import (
+	"reflect"
+	"strings"
+)
+
+// defaultBoolSynonyms are the texts read as booleans besides those accepted by strconv.ParseBool
+var defaultBoolSynonyms = map[string]bool{
+	"yes": true, "no": false,
+	"y": true, "n": false,
+	"on": true, "off": false,
+}
+
+// WithBoolSynonyms adds texts read as true or false by bool fields on import, e.g. {"ja": true, "nein": false}.
+// Texts are compared case-insensitively after trimming white space. Besides the values accepted by strconv.ParseBool,
+// "yes"/"no", "y"/"n" and "on"/"off" are always recognized.
+func WithBoolSynonyms(synonyms map[string]bool) Option {
+	return func(c *config) {
+		if c.boolSynonyms == nil {
+			c.boolSynonyms = make(map[string]bool)
+		}
+		for text, value := range synonyms {
+			c.boolSynonyms[strings.ToLower(strings.TrimSpace(text))] = value
+		}
+	}
+}
+
+// boolSynonym returns "true" or "false" for a text cell value that is a configured or default synonym,
+// and the value unchanged otherwise
+func boolSynonym(value interface{}, synonyms map[string]bool) interface{} {
+	text, ok := value.(string)
+	if !ok {
+		return value
+	}
+
+	key := strings.ToLower(strings.TrimSpace(text))
+	b, ok := synonyms[key]
+	if !ok {
+		b, ok = defaultBoolSynonyms[key]
+	}
+	if !ok {
+		return value
+	}
+	if b {
+		return "true"
+	}
+	return "false"
+}
+
+// isBoolType reports whether t is a bool field type, after dereferencing pointers
+func isBoolType(t reflect.Type) bool {
+	return t != nil && t.Kind() == reflect.Bool
+}
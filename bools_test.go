@@ -0,0 +1,43 @@
+package xlsx_utilities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBoolSynonyms(t *testing.T) {
+	type setting struct {
+		Name    string
+		Enabled bool
+		Visible *bool
+	}
+
+	newData := func() *ExcelData[setting] {
+		excelData := NewExcelData[setting]([]string{"Name", "Enabled", "Visible"})
+		for _, pair := range [][2]string{{"Yes", "no"}, {"y", "N"}, {"ON", "off"}, {"1", "0"}, {"Ja", "Nein"}} {
+			excelData.AddRow([]interface{}{"x", pair[0], pair[1]})
+		}
+		return excelData
+	}
+
+	f, tr := false, true
+
+	t.Run("Default synonyms", func(t *testing.T) {
+		result := newData().ToStruct()
+		assert.Empty(t, result.Errors)
+		assert.Equal(t, []setting{
+			{"x", true, &f},
+			{"x", true, &f},
+			{"x", true, &f},
+			{"x", true, &f},
+			{"x", false, &f},
+		}, result.Data)
+	})
+
+	t.Run("Localized synonyms", func(t *testing.T) {
+		result := newData().WithOptions(WithBoolSynonyms(map[string]bool{"ja": true, "nein": false, "Off": true})).ToStruct()
+		assert.Equal(t, setting{"x", true, &f}, result.Data[4])
+		assert.Equal(t, setting{"x", true, &tr}, result.Data[2])
+	})
+}
@@ -0,0 +1,39 @@
+package xlsx_utilities
+
+import "fmt"
+
+// Get returns the value of the cell at the 0-based row index and header, nil when the row is short
+func (ed *ExcelData[T]) Get(rowIndex int, header string) (interface{}, error) {
+	col, err := ed.cellColumn(rowIndex, header)
+	if err != nil {
+		return nil, err
+	}
+	return cellAt(ed.Rows[rowIndex], col), nil
+}
+
+// Set replaces the value of the cell at the 0-based row index and header, padding a short row with empty cells
+func (ed *ExcelData[T]) Set(rowIndex int, header string, value interface{}) error {
+	col, err := ed.cellColumn(rowIndex, header)
+	if err != nil {
+		return err
+	}
+
+	for len(ed.Rows[rowIndex]) <= col {
+		ed.Rows[rowIndex] = append(ed.Rows[rowIndex], nil)
+	}
+	ed.Rows[rowIndex][col] = value
+	return nil
+}
+
+// cellColumn returns the column of the header after checking the row index
+func (ed *ExcelData[T]) cellColumn(rowIndex int, header string) (int, error) {
+	if rowIndex < 0 || rowIndex >= len(ed.Rows) {
+		return -1, fmt.Errorf("row %d out of range", rowIndex)
+	}
+
+	col := indexOf(ed.Headers, header)
+	if col == -1 {
+		return -1, fmt.Errorf("unknown column '%s'", header)
+	}
+	return col, nil
+}
@@ -0,0 +1,28 @@
+package xlsx_utilities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCellAccessors(t *testing.T) {
+	excelData := NewExcelData[person]([]string{"Name", "Age"})
+	assert.NoError(t, excelData.AddRow([]interface{}{"Alice", 30}))
+	excelData.Rows = append(excelData.Rows, []interface{}{"Bob"})
+
+	value, err := excelData.Get(0, "Age")
+	assert.NoError(t, err)
+	assert.Equal(t, 30, value)
+
+	value, err = excelData.Get(1, "Age")
+	assert.NoError(t, err)
+	assert.Nil(t, value)
+
+	assert.NoError(t, excelData.Set(1, "Age", 25))
+	assert.Equal(t, []interface{}{"Bob", 25}, excelData.Rows[1])
+
+	_, err = excelData.Get(2, "Name")
+	assert.ErrorContains(t, err, "row 2 out of range")
+	assert.ErrorContains(t, excelData.Set(0, "Salary", 1), "unknown column 'Salary'")
+}
@@ -0,0 +1,134 @@
+package xlsx_utilities
+
+import (
+	"fmt"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// ChartType is the kind of chart generated by AddChart
+type ChartType int
+
+const (
+	// Bar is a vertical bar (column) chart
+	Bar ChartType = iota
+	// HorizontalBar is a horizontal bar chart
+	HorizontalBar
+	// Line is a line chart
+	Line
+	// Pie is a pie chart; only the first series is used
+	Pie
+)
+
+// chartTypes maps chart types to their excelize counterparts
+var chartTypes = map[ChartType]excelize.ChartType{
+	Bar:           excelize.Col,
+	HorizontalBar: excelize.Bar,
+	Line:          excelize.Line,
+	Pie:           excelize.Pie,
+}
+
+// ChartSpec describes a chart referencing exported columns by header name
+type ChartSpec struct {
+	Type   ChartType
+	Title  string
+	X      string   // header of the category column
+	Series []string // headers of the value columns
+	Cell   string   // top-left cell of the chart; defaults to the right of the data
+	Width  uint     // defaults to 480
+	Height uint     // defaults to 290
+}
+
+// WithChart adds a chart over the exported data. It can be given several times.
+func WithChart(spec ChartSpec) Option {
+	return func(c *config) {
+		c.charts = append(c.charts, spec)
+	}
+}
+
+// AddChart adds a chart to every later export of the ExcelData
+func (ed *ExcelData[T]) AddChart(spec ChartSpec) *ExcelData[T] {
+	return ed.WithOptions(WithChart(spec))
+}
+
+// applyCharts adds the configured charts next to the exported data
+func applyCharts(f *excelize.File, layout sheetLayout, cfg *config, headers []string) error {
+	for _, spec := range cfg.charts {
+		if err := addChart(f, layout, spec, headers); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func addChart(f *excelize.File, layout sheetLayout, spec ChartSpec, headers []string) error {
+	chartType, ok := chartTypes[spec.Type]
+	if !ok {
+		return fmt.Errorf("unsupported chart type: %d", spec.Type)
+	}
+
+	if !layout.HeaderRow {
+		return fmt.Errorf("chart requires a header row")
+	}
+
+	if len(spec.Series) == 0 {
+		return fmt.Errorf("chart requires at least one series")
+	}
+
+	columnIndex := func(header string) (int, error) {
+		for i, h := range headers {
+			if h == header {
+				return i, nil
+			}
+		}
+		return 0, fmt.Errorf("unknown chart column '%s'", header)
+	}
+
+	x, err := columnIndex(spec.X)
+	if err != nil {
+		return err
+	}
+
+	sheet := quoteSheetName(layout.Sheet)
+	column := func(i int) string {
+		col := layout.Col + i
+		return absoluteRange(sheet, col, layout.firstDataRow(), col, layout.lastRow())
+	}
+
+	series := spec.Series
+	if spec.Type == Pie {
+		series = series[:1]
+	}
+
+	chart := &excelize.Chart{Type: chartType}
+	for _, header := range series {
+		i, err := columnIndex(header)
+		if err != nil {
+			return err
+		}
+		chart.Series = append(chart.Series, excelize.ChartSeries{
+			Name:       fmt.Sprintf("%s!$%s$%d", sheet, intToExcelColumn(layout.Col-1+i), layout.Row),
+			Categories: column(x),
+			Values:     column(i),
+		})
+	}
+
+	if spec.Title != "" {
+		chart.Title = []excelize.RichTextRun{{Text: spec.Title}}
+	}
+
+	chart.Dimension = excelize.ChartDimension{Width: 480, Height: 290}
+	if spec.Width > 0 {
+		chart.Dimension.Width = spec.Width
+	}
+	if spec.Height > 0 {
+		chart.Dimension.Height = spec.Height
+	}
+
+	cell := spec.Cell
+	if cell == "" {
+		cell = layout.cell(layout.Cols+1, layout.Row)
+	}
+
+	return f.AddChart(layout.Sheet, cell, chart)
+}
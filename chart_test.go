@@ -0,0 +1,55 @@
+package xlsx_utilities
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/xuri/excelize/v2"
+)
+
+func TestAddChart(t *testing.T) {
+	type month struct {
+		Month   string
+		Revenue float64
+		Cost    float64
+	}
+
+	data := []month{
+		{Month: "Jan", Revenue: 100, Cost: 60},
+		{Month: "Feb", Revenue: 120, Cost: 70},
+	}
+
+	t.Run("Bar chart", func(t *testing.T) {
+		excelData, err := FromStruct(data)
+		assert.NoError(t, err)
+		excelData.AddChart(ChartSpec{Type: Bar, Title: "Revenue vs Cost", X: "Month", Series: []string{"Revenue", "Cost"}})
+
+		filename := filepath.Join(t.TempDir(), "chart.xlsx")
+		assert.NoError(t, excelData.Save(filename, WithSheet("Monthly Report")))
+
+		f, err := excelize.OpenFile(filename)
+		assert.NoError(t, err)
+		defer f.Close()
+
+		var chartXML string
+		f.Pkg.Range(func(key, value interface{}) bool {
+			if strings.HasPrefix(key.(string), "xl/charts/chart") {
+				chartXML = string(value.([]byte))
+			}
+			return true
+		})
+		assert.Contains(t, chartXML, "&#39;Monthly Report&#39;!$B$2:$B$3")
+		assert.Contains(t, chartXML, "&#39;Monthly Report&#39;!$A$2:$A$3")
+		assert.Contains(t, chartXML, "&#39;Monthly Report&#39;!$C$1")
+	})
+
+	t.Run("Unknown column", func(t *testing.T) {
+		excelData, err := FromStruct(data)
+		assert.NoError(t, err)
+		_, err = excelData.ToWorkbook(WithChart(ChartSpec{Type: Line, X: "Month", Series: []string{"Profit"}}))
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "unknown chart column 'Profit'")
+	})
+}
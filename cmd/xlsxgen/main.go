@@ -0,0 +1,40 @@
+// Command xlsxgen prints a Go struct matching the columns of an Excel sheet.
+//
+// Usage:
+//
+//	xlsxgen [-sheet name] [-package name] file.xlsx
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	xlsx "github.com/darmawan01/xlsx_utilities"
+)
+
+func main() {
+	sheet := flag.String("sheet", "", "sheet to read (default: first visible sheet)")
+	pkg := flag.String("package", "", "emit a complete file in this package")
+	flag.Parse()
+
+	if flag.NArg() != 1 {
+		fmt.Fprintln(os.Stderr, "usage: xlsxgen [-sheet name] [-package name] file.xlsx")
+		os.Exit(2)
+	}
+
+	src, err := xlsx.GenerateStruct(flag.Arg(0), *sheet)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "xlsxgen:", err)
+		os.Exit(1)
+	}
+
+	if *pkg != "" {
+		fmt.Printf("package %s\n\n", *pkg)
+		if strings.Contains(src, "time.Time") {
+			fmt.Print("import \"time\"\n\n")
+		}
+	}
+	fmt.Print(src)
+}
@@ -0,0 +1,421 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// pkgTypes holds the type declarations of the package the methods are generated in
+type pkgTypes struct {
+	name  string
+	types map[string]ast.Expr
+}
+
+// loadPackage parses the non-test Go files of dir, skipping the output file of a previous run
+func loadPackage(dir, output string) (*pkgTypes, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return nil, err
+	}
+
+	pkg := &pkgTypes{types: make(map[string]ast.Expr)}
+	fset := token.NewFileSet()
+	for _, filename := range files {
+		if strings.HasSuffix(filename, "_test.go") || filepath.Clean(filename) == filepath.Clean(output) {
+			continue
+		}
+		file, err := parser.ParseFile(fset, filename, nil, parser.SkipObjectResolution)
+		if err != nil {
+			return nil, err
+		}
+		if err := pkg.add(file); err != nil {
+			return nil, err
+		}
+	}
+
+	if pkg.name == "" {
+		return nil, fmt.Errorf("no Go files in %s", dir)
+	}
+	return pkg, nil
+}
+
+// add records the type declarations of a parsed file
+func (p *pkgTypes) add(file *ast.File) error {
+	if p.name == "" {
+		p.name = file.Name.Name
+	} else if p.name != file.Name.Name {
+		return fmt.Errorf("found packages %s and %s", p.name, file.Name.Name)
+	}
+
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.TYPE {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			spec := spec.(*ast.TypeSpec)
+			p.types[spec.Name.Name] = spec.Type
+		}
+	}
+	return nil
+}
+
+// column is a field mapped to a column, or a struct whose fields are mapped to columns
+type column struct {
+	header    string
+	expr      string // the field selected from the receiver, e.g. r.Address.City
+	typ       string // the declared type, without the pointer
+	kind      string // the name of the xlsx.Cell helper parsing the value; empty for structs
+	ptr       bool
+	omitEmpty bool // zero values and nil pointers are written as blank cells
+	order     *int // the position from the order tag option, which moves the column before unordered ones
+	children  []*column
+}
+
+// hasOption reports whether the comma-separated tag options contain the option
+func hasOption(options, option string) bool {
+	for _, opt := range strings.Split(options, ",") {
+		if opt == option {
+			return true
+		}
+	}
+	return false
+}
+
+// optionValue returns the value of a key=value tag option, e.g. 2 for order in "omitempty,order=2"
+func optionValue(options, key string) (string, bool) {
+	for _, opt := range strings.Split(options, ",") {
+		if value, ok := strings.CutPrefix(opt, key+"="); ok {
+			return value, true
+		}
+	}
+	return "", false
+}
+
+// unsupportedOptions are the xlsx tag options implemented by the reflection path only
+var unsupportedOptions = []string{"map", "json", "text", "roles"}
+
+// unsupportedOption returns the first option of the tag the generated methods would silently ignore
+func unsupportedOption(options string) (string, bool) {
+	for _, opt := range strings.Split(options, ",") {
+		key, _, _ := strings.Cut(opt, "=")
+		if slices.Contains(unsupportedOptions, key) {
+			return opt, true
+		}
+	}
+	return "", false
+}
+
+// basicKinds maps predeclared types to the xlsx.Cell helper parsing them
+var basicKinds = map[string]string{
+	"string": "String",
+	"int":    "Int", "int8": "Int", "int16": "Int", "int32": "Int", "int64": "Int",
+	"uint": "Uint", "uint8": "Uint", "uint16": "Uint", "uint32": "Uint", "uint64": "Uint",
+	"float32": "Float", "float64": "Float",
+	"bool": "Bool",
+}
+
+// helperTypes are the types returned by the xlsx.Cell helpers
+var helperTypes = map[string]string{
+	"String": "string",
+	"Int":    "int64",
+	"Uint":   "uint64",
+	"Float":  "float64",
+	"Bool":   "bool",
+	"Time":   "time.Time",
+}
+
+// zeroValues are the values written for fields behind a nil pointer, matching the reflection path
+var zeroValues = map[string]string{
+	"String": `""`,
+	"Int":    "int64(0)",
+	"Uint":   "uint64(0)",
+	"Float":  "float64(0)",
+	"Bool":   "false",
+	"Time":   "nil",
+}
+
+// columns returns the columns of the named struct type, in field order
+func (p *pkgTypes) columns(name, prefix, expr string, seen map[string]bool) ([]*column, error) {
+	st, ok := p.types[name].(*ast.StructType)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a struct type in package %s", name, p.name)
+	}
+	if seen[name] {
+		return nil, fmt.Errorf("%s is recursive", name)
+	}
+	seen[name] = true
+	defer delete(seen, name)
+
+	var columns []*column
+	for _, field := range st.Fields.List {
+		var tag reflect.StructTag
+		if field.Tag != nil {
+			value, err := strconv.Unquote(field.Tag.Value)
+			if err != nil {
+				return nil, err
+			}
+			tag = reflect.StructTag(value)
+		}
+		if tag.Get("xlsx") == "-" {
+			continue
+		}
+
+		names := field.Names
+		if len(names) == 0 {
+			names = []*ast.Ident{embeddedName(field.Type)}
+		}
+		for _, ident := range names {
+			if ident == nil || !ident.IsExported() {
+				continue
+			}
+
+			header, options, _ := strings.Cut(tag.Get("xlsx"), ",")
+			if opt, ok := unsupportedOption(options); ok {
+				return nil, fmt.Errorf("%s.%s: unsupported tag option %q; use the reflection based functions for this type", name, ident.Name, opt)
+			}
+			if header == "" {
+				header = ident.Name
+			}
+			if prefix != "" {
+				header = prefix + " " + header
+			}
+
+			c, err := p.column(field.Type, header, expr+"."+ident.Name, seen)
+			if err != nil {
+				return nil, fmt.Errorf("%s.%s: %v", name, ident.Name, err)
+			}
+			c.omitEmpty = c.kind != "" && hasOption(options, "omitempty")
+			if value, ok := optionValue(options, "order"); ok {
+				order, err := strconv.Atoi(value)
+				if err != nil {
+					return nil, fmt.Errorf("%s.%s: invalid order %q", name, ident.Name, value)
+				}
+				c.order = &order
+			}
+			columns = append(columns, c)
+		}
+	}
+
+	sort.SliceStable(columns, func(a, b int) bool {
+		return columns[a].order != nil && (columns[b].order == nil || *columns[a].order < *columns[b].order)
+	})
+	return columns, nil
+}
+
+// column resolves the type of a field
+func (p *pkgTypes) column(typ ast.Expr, header, expr string, seen map[string]bool) (*column, error) {
+	c := &column{header: header, expr: expr}
+	if star, ok := typ.(*ast.StarExpr); ok {
+		c.ptr = true
+		typ = star.X
+	}
+
+	switch t := typ.(type) {
+	case *ast.SelectorExpr:
+		if pkg, ok := t.X.(*ast.Ident); ok && pkg.Name == "time" && t.Sel.Name == "Time" {
+			c.typ, c.kind = "time.Time", "Time"
+			return c, nil
+		}
+	case *ast.Ident:
+		c.typ = t.Name
+		if kind, ok := p.kind(t.Name); ok {
+			c.kind = kind
+			return c, nil
+		}
+		if _, ok := p.types[t.Name].(*ast.StructType); ok {
+			children, err := p.columns(t.Name, header, expr, seen)
+			if err != nil {
+				return nil, err
+			}
+			c.children = children
+			return c, nil
+		}
+	}
+
+	return nil, fmt.Errorf("unsupported type %s; use the reflection based functions for this type", exprString(typ))
+}
+
+// kind returns the xlsx.Cell helper for a predeclared type or a named type defined from one
+func (p *pkgTypes) kind(name string) (string, bool) {
+	for i := 0; i < 10; i++ {
+		if kind, ok := basicKinds[name]; ok {
+			return kind, true
+		}
+		ident, ok := p.types[name].(*ast.Ident)
+		if !ok {
+			return "", false
+		}
+		name = ident.Name
+	}
+	return "", false
+}
+
+// embeddedName returns the field name of an embedded field
+func embeddedName(typ ast.Expr) *ast.Ident {
+	if star, ok := typ.(*ast.StarExpr); ok {
+		typ = star.X
+	}
+	switch t := typ.(type) {
+	case *ast.Ident:
+		return t
+	case *ast.SelectorExpr:
+		return t.Sel
+	}
+	return nil
+}
+
+// exprString formats a type expression for error messages
+func exprString(typ ast.Expr) string {
+	var buf bytes.Buffer
+	format.Node(&buf, token.NewFileSet(), typ)
+	return buf.String()
+}
+
+// generate returns the formatted source of the methods of the named types
+func (p *pkgTypes) generate(names []string) ([]byte, error) {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by xlsxrowgen; DO NOT EDIT.\n\npackage %s\n\n", p.name)
+	buf.WriteString("import (\n\t\"fmt\"\n\n\txlsx \"github.com/darmawan01/xlsx_utilities\"\n)\n")
+
+	for _, name := range names {
+		name = strings.TrimSpace(name)
+		columns, err := p.columns(name, "", "r", map[string]bool{})
+		if err != nil {
+			return nil, err
+		}
+
+		fmt.Fprintf(&buf, "\n// MarshalXLSXRow returns the cell values of the %s columns in header order\n", name)
+		fmt.Fprintf(&buf, "func (r %s) MarshalXLSXRow() ([]interface{}, error) {\n", name)
+		fmt.Fprintf(&buf, "row := make([]interface{}, 0, %d)\n", countLeaves(columns))
+		writeValues(&buf, columns)
+		buf.WriteString("return row, nil\n}\n")
+
+		fmt.Fprintf(&buf, "\n// SetXLSXField sets the %s field named by the header from a cell value\n", name)
+		fmt.Fprintf(&buf, "func (r *%s) SetXLSXField(header string, value interface{}) error {\nswitch header {\n", name)
+		writeSetters(&buf, columns, nil)
+		buf.WriteString("default:\nreturn fmt.Errorf(\"no such field: %s\", header)\n}\nreturn nil\n}\n")
+	}
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("formatting generated code: %v", err)
+	}
+	return src, nil
+}
+
+// countLeaves returns the number of cells written for the columns
+func countLeaves(columns []*column) int {
+	n := 0
+	for _, c := range columns {
+		if c.kind != "" {
+			n++
+		} else {
+			n += countLeaves(c.children)
+		}
+	}
+	return n
+}
+
+// writeValues writes the statements appending the cell values of the columns to row
+func writeValues(buf *bytes.Buffer, columns []*column) {
+	for _, c := range columns {
+		switch {
+		case c.kind != "" && !c.ptr && c.omitEmpty:
+			fmt.Fprintf(buf, "if %s {\nrow = append(row, nil)\n} else {\nrow = append(row, %s)\n}\n", isZero(c), c.expr)
+		case c.kind != "" && !c.ptr:
+			fmt.Fprintf(buf, "row = append(row, %s)\n", c.expr)
+		case c.kind != "":
+			zero := zeroValues[c.kind]
+			if c.omitEmpty {
+				zero = "nil"
+			}
+			fmt.Fprintf(buf, "if %s != nil {\nrow = append(row, *%s)\n} else {\nrow = append(row, %s)\n}\n", c.expr, c.expr, zero)
+		case !c.ptr:
+			writeValues(buf, c.children)
+		default:
+			fmt.Fprintf(buf, "if %s != nil {\n", c.expr)
+			writeValues(buf, c.children)
+			buf.WriteString("} else {\n")
+			writeZeros(buf, c.children)
+			buf.WriteString("}\n")
+		}
+	}
+}
+
+// isZero returns the condition testing a non-pointer field for its zero value
+func isZero(c *column) string {
+	switch c.kind {
+	case "Time":
+		return c.expr + ".IsZero()"
+	case "String":
+		return c.expr + ` == ""`
+	case "Bool":
+		return "!" + c.expr
+	}
+	return c.expr + " == 0"
+}
+
+// writeZeros writes the statements appending the zero values of the columns behind a nil pointer
+func writeZeros(buf *bytes.Buffer, columns []*column) {
+	for _, c := range columns {
+		if c.kind != "" {
+			fmt.Fprintf(buf, "row = append(row, %s)\n", zeroValues[c.kind])
+		} else {
+			writeZeros(buf, c.children)
+		}
+	}
+}
+
+// writeSetters writes a case per column, allocating the nil struct pointers on the path to the field
+func writeSetters(buf *bytes.Buffer, columns []*column, path []*column) {
+	for _, c := range columns {
+		if c.kind == "" {
+			writeSetters(buf, c.children, append(path, c))
+			continue
+		}
+
+		fmt.Fprintf(buf, "case %q:\n", c.header)
+		if c.ptr || hasPointer(path) {
+			buf.WriteString("if value == nil || value == \"\" {\nreturn nil\n}\n")
+		}
+		for _, parent := range path {
+			if parent.ptr {
+				fmt.Fprintf(buf, "if %s == nil {\n%s = new(%s)\n}\n", parent.expr, parent.expr, parent.typ)
+			}
+		}
+		fmt.Fprintf(buf, "v, err := xlsx.Cell%s(value)\nif err != nil {\nreturn err\n}\n", c.kind)
+
+		converted := "v"
+		if c.typ != helperTypes[c.kind] {
+			converted = c.typ + "(v)"
+		}
+		if c.ptr && converted == "v" {
+			fmt.Fprintf(buf, "%s = &v\n", c.expr)
+		} else if c.ptr {
+			fmt.Fprintf(buf, "x := %s\n%s = &x\n", converted, c.expr)
+		} else {
+			fmt.Fprintf(buf, "%s = %s\n", c.expr, converted)
+		}
+	}
+}
+
+// hasPointer reports whether any of the columns on the path is a pointer, which stays nil for empty cells
+func hasPointer(path []*column) bool {
+	for _, c := range path {
+		if c.ptr {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,96 @@
+package main
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const source = `package shop
+
+import "time"
+
+type Status string
+
+type Address struct {
+	City string
+	Zip  *int ` + "`xlsx:\"Postal Code\"`" + `
+}
+
+type Order struct {
+	ID      int64
+	Status  Status
+	Placed  time.Time
+	Ship    *Address
+	Note    string ` + "`xlsx:\"-\"`" + `
+	Count   int    ` + "`xlsx:\"Count,omitempty\"`" + `
+	Rank    *int   ` + "`xlsx:\",omitempty\"`" + `
+	secret  int
+}
+
+type Ranked struct {
+	Name  string
+	ID    int    ` + "`xlsx:\"ID,order=1\"`" + `
+	Notes string
+	Code  string ` + "`xlsx:\",order=2\"`" + `
+}
+
+type Tagged struct {
+	Lines []string
+}
+
+type Mapped struct {
+	Status int ` + "`xlsx:\"Status,map=1:Open;2:Closed\"`" + `
+}
+`
+
+func parseSource(t *testing.T) *pkgTypes {
+	file, err := parser.ParseFile(token.NewFileSet(), "shop.go", source, 0)
+	assert.NoError(t, err)
+	pkg := &pkgTypes{types: map[string]ast.Expr{}}
+	assert.NoError(t, pkg.add(file))
+	return pkg
+}
+
+func TestGenerate(t *testing.T) {
+	t.Run("Methods", func(t *testing.T) {
+		src, err := parseSource(t).generate([]string{"Order"})
+		assert.NoError(t, err)
+
+		code := string(src)
+		assert.True(t, strings.HasPrefix(code, "// Code generated by xlsxrowgen; DO NOT EDIT.\n\npackage shop\n"))
+		assert.Contains(t, code, "row := make([]interface{}, 0, 7)")
+		assert.Contains(t, code, "\tif r.Count == 0 {\n\t\trow = append(row, nil)\n\t} else {\n\t\trow = append(row, r.Count)\n\t}")
+		assert.Contains(t, code, "\tif r.Rank != nil {\n\t\trow = append(row, *r.Rank)\n\t} else {\n\t\trow = append(row, nil)\n\t}")
+		assert.Contains(t, code, "\t} else {\n\t\trow = append(row, \"\")\n\t\trow = append(row, int64(0))\n\t}")
+		assert.Contains(t, code, "\tcase \"Ship Postal Code\":\n\t\tif value == nil || value == \"\" {\n\t\t\treturn nil\n\t\t}\n\t\tif r.Ship == nil {\n\t\t\tr.Ship = new(Address)\n\t\t}")
+		assert.Contains(t, code, "\tcase \"Rank\":\n\t\tif value == nil || value == \"\" {\n\t\t\treturn nil\n\t\t}")
+		assert.Contains(t, code, "r.Status = Status(v)")
+		assert.Contains(t, code, "x := int(v)\n\t\tr.Ship.Zip = &x")
+		assert.NotContains(t, code, "Note")
+		assert.NotContains(t, code, "secret")
+	})
+
+	t.Run("Column order", func(t *testing.T) {
+		src, err := parseSource(t).generate([]string{"Ranked"})
+		assert.NoError(t, err)
+
+		code := string(src)
+		assert.Contains(t, code, "\trow = append(row, r.ID)\n\trow = append(row, r.Code)\n\trow = append(row, r.Name)\n\trow = append(row, r.Notes)\n")
+	})
+
+	t.Run("Unsupported fields", func(t *testing.T) {
+		_, err := parseSource(t).generate([]string{"Tagged"})
+		assert.EqualError(t, err, "Tagged.Lines: unsupported type []string; use the reflection based functions for this type")
+
+		_, err = parseSource(t).generate([]string{"Mapped"})
+		assert.EqualError(t, err, "Mapped.Status: unsupported tag option \"map=1:Open;2:Closed\"; use the reflection based functions for this type")
+
+		_, err = parseSource(t).generate([]string{"Missing"})
+		assert.EqualError(t, err, "Missing is not a struct type in package shop")
+	})
+}
@@ -0,0 +1,59 @@
+// Command xlsxrowgen generates MarshalXLSXRow and SetXLSXField methods for structs,
+// so FromStruct, StreamWriter and ToStruct skip reflection for those types.
+//
+// Usage, from a file in the package declaring the types:
+//
+//	//go:generate go run github.com/darmawan01/xlsx_utilities/cmd/xlsxrowgen -type Order,Customer
+//
+// Fields may be strings, integers, floats, bools, time.Time, named types of those kinds,
+// pointers to any of them, and nested or embedded structs declared in the same package.
+// Types with other fields (slices, maps, types from other packages) keep using reflection, as do
+// types with fields tagged map=, json, text or roles, which only the reflection path implements.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+func main() {
+	types := flag.String("type", "", "comma-separated list of struct type names")
+	output := flag.String("output", "", "output file (default: <first type>_xlsx.go)")
+	flag.Parse()
+
+	if *types == "" {
+		fmt.Fprintln(os.Stderr, "usage: xlsxrowgen -type T1,T2 [-output file] [dir]")
+		os.Exit(2)
+	}
+
+	dir := "."
+	if flag.NArg() > 0 {
+		dir = flag.Arg(0)
+	}
+
+	names := strings.Split(*types, ",")
+	filename := *output
+	if filename == "" {
+		filename = filepath.Join(dir, strings.ToLower(names[0])+"_xlsx.go")
+	}
+
+	pkg, err := loadPackage(dir, filename)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "xlsxrowgen:", err)
+		os.Exit(1)
+	}
+
+	src, err := pkg.generate(names)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "xlsxrowgen:", err)
+		os.Exit(1)
+	}
+
+	if err := os.WriteFile(filename, src, 0o644); err != nil {
+		fmt.Fprintln(os.Stderr, "xlsxrowgen:", err)
+		os.Exit(1)
+	}
+}
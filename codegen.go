@@ -0,0 +1,143 @@
+package xlsx_utilities
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// generateSampleRows is the number of data rows used to infer the column types
+const generateSampleRows = 100
+
+// GenerateStruct returns the source of a Go struct matching the sheet, to bootstrap import code for a new file format.
+// Each column becomes a field tagged with its header, typed after the first sample rows as int, float64, bool,
+// time.Time or string. The type is named after the sheet; an empty sheet name uses the first visible sheet.
+func GenerateStruct(filename, sheet string) (string, error) {
+	f, err := excelize.OpenFile(filename)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	if sheet == "" {
+		sheets, err := visibleSheets(f)
+		if err != nil {
+			return "", err
+		}
+		if len(sheets) == 0 {
+			return "", fmt.Errorf("workbook has no visible sheet")
+		}
+		sheet = sheets[0]
+	}
+
+	rows, err := readRowsPrefix(f, &config{sheet: sheet}, 1+generateSampleRows)
+	if err != nil {
+		return "", err
+	}
+	return generateStruct(goIdentifier(sheet, "Record"), rows)
+}
+
+// generateStruct returns the formatted struct declaration for the header row and sample rows
+func generateStruct(name string, rows [][]string) (string, error) {
+	if len(rows) == 0 || len(rows[0]) == 0 {
+		return "", fmt.Errorf("sheet has no header row")
+	}
+
+	var src bytes.Buffer
+	fmt.Fprintf(&src, "type %s struct {\n", name)
+
+	used := make(map[string]bool)
+	for col, header := range rows[0] {
+		field := goIdentifier(header, fmt.Sprintf("Column%d", col+1))
+		for n := 2; used[field]; n++ {
+			field = fmt.Sprintf("%s%d", goIdentifier(header, "Column"), n)
+		}
+		used[field] = true
+
+		tag := tagName + ":" + strconv.Quote(header)
+		if strings.Contains(tag, "`") {
+			fmt.Fprintf(&src, "\t%s %s %s\n", field, inferColumnType(rows[1:], col), strconv.Quote(tag))
+		} else {
+			fmt.Fprintf(&src, "\t%s %s `%s`\n", field, inferColumnType(rows[1:], col), tag)
+		}
+	}
+	src.WriteString("}\n")
+
+	formatted, err := format.Source(src.Bytes())
+	if err != nil {
+		return "", err
+	}
+	return string(formatted), nil
+}
+
+// inferColumnType returns the narrowest Go type all non-empty sample values of the column parse as
+func inferColumnType(rows [][]string, col int) string {
+	candidates := map[string]func(string) bool{
+		"int":       func(s string) bool { _, err := strconv.Atoi(s); return err == nil },
+		"float64":   func(s string) bool { _, err := strconv.ParseFloat(s, 64); return err == nil },
+		"bool":      func(s string) bool { _, err := strconv.ParseBool(s); return err == nil },
+		"time.Time": isDateText,
+	}
+	order := []string{"int", "float64", "bool", "time.Time"}
+
+	seen := false
+	for _, row := range rows {
+		if col >= len(row) || strings.TrimSpace(row[col]) == "" {
+			continue
+		}
+		seen = true
+		for name, parses := range candidates {
+			if !parses(strings.TrimSpace(row[col])) {
+				delete(candidates, name)
+			}
+		}
+	}
+
+	if seen {
+		for _, name := range order {
+			if candidates[name] != nil {
+				return name
+			}
+		}
+	}
+	return "string"
+}
+
+// isDateText reports whether the text is an RFC 3339 date, the format time.Time fields are imported from
+func isDateText(s string) bool {
+	_, err := time.Parse(time.RFC3339, s)
+	return err == nil
+}
+
+// goIdentifier converts text to an exported Go identifier, e.g. "order id" to "OrderId",
+// returning fallback when the text has no letters or digits
+func goIdentifier(text, fallback string) string {
+	var b strings.Builder
+	upper := true
+	for _, r := range text {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			upper = true
+			continue
+		}
+		if upper {
+			r = unicode.ToUpper(r)
+			upper = false
+		}
+		b.WriteRune(r)
+	}
+
+	name := b.String()
+	if name == "" {
+		return fallback
+	}
+	if first := []rune(name)[0]; !unicode.IsUpper(first) {
+		name = "F" + name
+	}
+	return name
+}
@@ -0,0 +1,106 @@
+package xlsx_utilities
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGenerateStruct(t *testing.T) {
+	filename := "test_generate.xlsx"
+	defer os.Remove(filename)
+
+	excelData := NewExcelData[person]([]string{"Order ID", "customer name", "Total", "Paid", "Created", "Notes", "2nd Line", "Total"})
+	excelData.AddRow([]interface{}{1001, "Alice", 12.5, true, time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC).Format(time.RFC3339), "", "x", 1})
+	excelData.AddRow([]interface{}{1002, "Bob", 8, false, "2024-03-02T10:00:00Z", "", "y", 2})
+	assert.NoError(t, excelData.Save(filename, WithSheet("Orders 2024")))
+
+	src, err := GenerateStruct(filename, "")
+	assert.NoError(t, err)
+	assert.Equal(t, "type Orders2024 struct {\n"+
+		"\tOrderID      int       `xlsx:\"Order ID\"`\n"+
+		"\tCustomerName string    `xlsx:\"customer name\"`\n"+
+		"\tTotal        float64   `xlsx:\"Total\"`\n"+
+		"\tPaid         bool      `xlsx:\"Paid\"`\n"+
+		"\tCreated      time.Time `xlsx:\"Created\"`\n"+
+		"\tNotes        string    `xlsx:\"Notes\"`\n"+
+		"\tF2ndLine     string    `xlsx:\"2nd Line\"`\n"+
+		"\tTotal2       int       `xlsx:\"Total\"`\n"+
+		"}\n", src)
+
+	_, err = GenerateStruct(filename, "Missing")
+	assert.Error(t, err)
+}
+
+func TestStructTags(t *testing.T) {
+	type contact struct {
+		Email string `xlsx:"E-mail Address"`
+	}
+	type customer struct {
+		ID       int    `xlsx:"Customer ID"`
+		Name     string `xlsx:"Full Name,omitempty"`
+		Internal string `xlsx:"-"`
+		Contact  contact
+	}
+
+	excelData, err := FromStruct([]customer{{ID: 7, Name: "Alice", Internal: "secret", Contact: contact{Email: "a@example.com"}}})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Customer ID", "Full Name", "Contact E-mail Address"}, excelData.Headers)
+	assert.Equal(t, [][]interface{}{{7, "Alice", "a@example.com"}}, excelData.Rows)
+
+	result := excelData.ToStruct()
+	assert.Empty(t, result.Errors)
+	assert.Equal(t, []customer{{ID: 7, Name: "Alice", Contact: contact{Email: "a@example.com"}}}, result.Data)
+}
+
+func TestOmitEmpty(t *testing.T) {
+	type address struct {
+		City string
+	}
+	type order struct {
+		ID      int
+		Count   int       `xlsx:"Count,omitempty"`
+		Paid    bool      `xlsx:",omitempty"`
+		Shipped time.Time `xlsx:",omitempty"`
+		Rank    *int      `xlsx:",omitempty"`
+		Score   *int
+		Address *address `xlsx:",omitempty"`
+	}
+
+	rank := 0
+	excelData, err := FromStruct([]order{{ID: 1}, {ID: 2, Count: 3, Paid: true, Rank: &rank}})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"ID", "Count", "Paid", "Shipped", "Rank", "Score", "Address City"}, excelData.Headers)
+	assert.Equal(t, []interface{}{1, nil, nil, nil, nil, int64(0), nil}, excelData.Rows[0])
+	assert.Equal(t, []interface{}{2, 3, true, nil, 0, int64(0), nil}, excelData.Rows[1])
+}
+
+func TestColumnOrder(t *testing.T) {
+	type address struct {
+		City string `xlsx:"City,order=1"`
+		Zip  string
+	}
+	type customer struct {
+		Name    string
+		ID      int `xlsx:"Customer ID,order=1"`
+		Address address
+		Email   string `xlsx:",order=2"`
+	}
+
+	excelData, err := FromStruct([]customer{{Name: "Alice", ID: 7, Address: address{City: "Paris", Zip: "75001"}, Email: "a@example.com"}})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Customer ID", "Email", "Name", "Address City", "Address Zip"}, excelData.Headers)
+	assert.Equal(t, [][]interface{}{{7, "a@example.com", "Alice", "Paris", "75001"}}, excelData.Rows)
+
+	result := excelData.ToStruct()
+	assert.Empty(t, result.Errors)
+	assert.Equal(t, []customer{{Name: "Alice", ID: 7, Address: address{City: "Paris", Zip: "75001"}, Email: "a@example.com"}}, result.Data)
+
+	type invalid struct {
+		Name string `xlsx:",order=first"`
+	}
+	_, err = FromStruct([]invalid{{Name: "Alice"}})
+	assert.EqualError(t, err, `error getting headers: invalid order "first" of field invalid.Name`)
+}
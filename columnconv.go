@@ -0,0 +1,55 @@
+package xlsx_utilities
+
+import "reflect"
+
+// WithColumnParser parses the cells of one column with the parser instead of by the type of its field,
+// e.g. WithColumnParser("Amount", parseMoney) when two string columns follow different rules. The parser
+// is given the cell text, including empty cells; a nil result leaves the field unset and an error is
+// reported as a ConversionError. The column is named by the header in the sheet or of the field.
+func WithColumnParser(column string, parser CustomTypeParser) Option {
+	return func(c *config) {
+		if c.columnParsers == nil {
+			c.columnParsers = make(map[string]CustomTypeParser)
+		}
+		c.columnParsers[column] = parser
+	}
+}
+
+// WithColumnFormatter writes the values of one column as the text returned by the formatter on export,
+// instead of as they are. The formatter is given the value of the cell in Rows; blank cells are not
+// formatted. The column is named by the header of the field.
+func WithColumnFormatter(column string, formatter CustomTypeConverter) Option {
+	return func(c *config) {
+		if c.columnFormatters == nil {
+			c.columnFormatters = make(map[string]CustomTypeConverter)
+		}
+		c.columnFormatters[column] = formatter
+	}
+}
+
+// columnParserFor returns the parser of a column by its header in the sheet or of the field
+func (c *config) columnParserFor(header, field string) CustomTypeParser {
+	if parser, ok := c.columnParsers[header]; ok {
+		return parser
+	}
+	return c.columnParsers[field]
+}
+
+// parseColumn parses the cell with the parser of its column into a value for a field of type t.
+// set is false when the parser leaves the field unset.
+func parseColumn(parser CustomTypeParser, cell interface{}, t reflect.Type) (value interface{}, set bool, err error) {
+	value, err = parser(cellText(cell))
+	if err != nil {
+		return nil, false, &ConversionError{Value: cell, Type: t, Err: err}
+	}
+	return value, value != nil, nil
+}
+
+// formatColumn returns the value of a cell of the column as written on export
+func (c *config) formatColumn(header string, value interface{}) (interface{}, error) {
+	formatter, ok := c.columnFormatters[header]
+	if !ok || value == nil {
+		return value, nil
+	}
+	return formatter(value)
+}
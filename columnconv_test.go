@@ -0,0 +1,61 @@
+package xlsx_utilities
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestColumnParsers(t *testing.T) {
+	type invoice struct {
+		Reference string
+		Customer  string
+		Amount    float64
+	}
+
+	excelData := NewExcelData[invoice]([]string{"Reference", "Customer", "Amount"})
+	excelData.AddRow([]interface{}{"inv-001", "acme", "$1,250.50"})
+	excelData.AddRow([]interface{}{"inv-002", "globex", "12 EUR"})
+	excelData.AddRow([]interface{}{"inv-003", "", ""})
+
+	upper := func(s string) (interface{}, error) {
+		if s == "" {
+			return nil, nil
+		}
+		return strings.ToUpper(s), nil
+	}
+	money := func(s string) (interface{}, error) {
+		if s == "" {
+			return nil, nil
+		}
+		return strconv.ParseFloat(strings.NewReplacer("$", "", ",", "").Replace(s), 64)
+	}
+
+	result := excelData.WithOptions(WithColumnParser("Reference", upper), WithColumnParser("Amount", money)).ToStruct()
+	assert.Equal(t, []invoice{{"INV-001", "acme", 1250.5}, {"INV-003", "", 0}}, result.Data)
+	if assert.Len(t, result.Errors, 1) {
+		var conversion *ConversionError
+		assert.ErrorAs(t, result.Errors[0], &conversion)
+		assert.Equal(t, "Amount", result.Errors[0].Header)
+	}
+
+	t.Run("Formatter", func(t *testing.T) {
+		data := []invoice{{"INV-001", "Acme", 1250.5}}
+		excelData, err := FromStruct(data)
+		assert.NoError(t, err)
+
+		currency := func(v interface{}) (string, error) {
+			return fmt.Sprintf("$%.2f", v), nil
+		}
+		f, err := excelData.ToWorkbook(WithColumnFormatter("Amount", currency))
+		assert.NoError(t, err)
+		defer f.Close()
+
+		rows, err := f.GetRows("Sheet1")
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"INV-001", "Acme", "$1250.50"}, rows[1])
+	})
+}
@@ -0,0 +1,74 @@
+package xlsx_utilities
+
+import (
+	"fmt"
+	"strings"
+)
+
+// SelectColumns returns a dataset with only the given columns, in the given order
+func (ed *ExcelData[T]) SelectColumns(headers ...string) (*ExcelData[T], error) {
+	cols := make([]int, len(headers))
+	var unknown []string
+	for i, header := range headers {
+		if cols[i] = indexOf(ed.Headers, header); cols[i] == -1 {
+			unknown = append(unknown, header)
+		}
+	}
+	if len(unknown) > 0 {
+		return nil, fmt.Errorf("unknown columns: %s", strings.Join(unknown, ", "))
+	}
+
+	return ed.project(cols), nil
+}
+
+// DropColumns returns a dataset without the given columns
+func (ed *ExcelData[T]) DropColumns(headers ...string) (*ExcelData[T], error) {
+	dropped := make(map[int]bool, len(headers))
+	var unknown []string
+	for _, header := range headers {
+		col := indexOf(ed.Headers, header)
+		if col == -1 {
+			unknown = append(unknown, header)
+		}
+		dropped[col] = true
+	}
+	if len(unknown) > 0 {
+		return nil, fmt.Errorf("unknown columns: %s", strings.Join(unknown, ", "))
+	}
+
+	var cols []int
+	for col := range ed.Headers {
+		if !dropped[col] {
+			cols = append(cols, col)
+		}
+	}
+	return ed.project(cols), nil
+}
+
+// project returns a copy of the dataset with the columns at the given indexes
+func (ed *ExcelData[T]) project(cols []int) *ExcelData[T] {
+	headers := make([]string, len(cols))
+	for i, col := range cols {
+		headers[i] = ed.Headers[col]
+	}
+
+	rows := make([][]interface{}, len(ed.Rows))
+	for r, row := range ed.Rows {
+		rows[r] = make([]interface{}, len(cols))
+		for i, col := range cols {
+			rows[r][i] = cellAt(row, col)
+		}
+	}
+
+	result := ed.view(rows)
+	result.Headers = headers
+	result.lines = ed.lines
+	for r := range ed.Provenance {
+		provenance := make([]Provenance, len(cols))
+		for i, col := range cols {
+			provenance[i] = ed.provenanceAt(r, col)
+		}
+		result.Provenance = append(result.Provenance, provenance)
+	}
+	return result
+}
@@ -0,0 +1,40 @@
+package xlsx_utilities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestColumnProjection(t *testing.T) {
+	excelData, err := FromStruct([]order{{ID: 1, Status: "paid", Total: 120}, {ID: 2, Status: "open", Total: 40}})
+	assert.NoError(t, err)
+	assert.NoError(t, excelData.SetProvenance(1, "Total", ProvenanceComputed))
+
+	t.Run("Select", func(t *testing.T) {
+		selected, err := excelData.SelectColumns("Total", "ID")
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"Total", "ID"}, selected.Headers)
+		assert.Equal(t, [][]interface{}{{120.0, 1}, {40.0, 2}}, selected.Rows)
+		assert.Equal(t, ProvenanceComputed, selected.provenanceAt(1, 0))
+
+		selected.Rows[0][0] = 0.0
+		assert.Equal(t, 120.0, excelData.Rows[0][2])
+	})
+
+	t.Run("Drop", func(t *testing.T) {
+		trimmed, err := excelData.DropColumns("Status")
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"ID", "Total"}, trimmed.Headers)
+		assert.Equal(t, [][]interface{}{{1, 120.0}, {2, 40.0}}, trimmed.Rows)
+		assert.Equal(t, ProvenanceComputed, trimmed.provenanceAt(1, 1))
+	})
+
+	t.Run("Unknown columns", func(t *testing.T) {
+		_, err := excelData.SelectColumns("ID", "Email", "Phone")
+		assert.EqualError(t, err, "unknown columns: Email, Phone")
+
+		_, err = excelData.DropColumns("Email")
+		assert.EqualError(t, err, "unknown columns: Email")
+	})
+}
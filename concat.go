@@ -0,0 +1,109 @@
+package xlsx_utilities
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Concat combines datasets with the same columns into one, for consolidating uploads of the same template.
+// Columns may come in a different order; they are rearranged to the order of the first dataset.
+// The result holds copies of the rows and uses the options of the first dataset.
+func Concat[T comparable](parts ...*ExcelData[T]) (*ExcelData[T], error) {
+	if len(parts) == 0 {
+		return nil, fmt.Errorf("nothing to concatenate")
+	}
+
+	first := parts[0]
+	headers := append([]string(nil), first.Headers...)
+	result := first.view(nil)
+	result.Headers = headers
+
+	tracked := false
+	for _, part := range parts {
+		tracked = tracked || len(part.Provenance) > 0
+	}
+
+	for n, part := range parts {
+		order, err := columnOrder(headers, part.Headers)
+		if err != nil {
+			return nil, fmt.Errorf("dataset %d: %w", n+1, err)
+		}
+
+		for r, row := range part.Rows {
+			combined := make([]interface{}, len(headers))
+			for i, col := range order {
+				if col < len(row) {
+					combined[i] = row[col]
+				}
+			}
+			result.Rows = append(result.Rows, combined)
+
+			if tracked {
+				provenance := make([]Provenance, len(headers))
+				for i, col := range order {
+					provenance[i] = part.provenanceAt(r, col)
+				}
+				result.Provenance = append(result.Provenance, provenance)
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// MergeExcelFiles reads the default sheet of each file and concatenates them, checking that their columns match
+func MergeExcelFiles[T comparable](files ...string) (*ExcelData[T], error) {
+	parts := make([]*ExcelData[T], 0, len(files))
+	for _, file := range files {
+		ed, err := FromExcel[T](file)
+		if err != nil {
+			return nil, fmt.Errorf("error reading '%s': %w", file, err)
+		}
+		if len(parts) > 0 {
+			if _, err := columnOrder(parts[0].Headers, ed.Headers); err != nil {
+				return nil, fmt.Errorf("'%s': %w", file, err)
+			}
+		}
+		parts = append(parts, ed)
+	}
+
+	return Concat(parts...)
+}
+
+// columnOrder returns, for each of the headers, the index of the same column in other.
+// It fails when other is missing any of the headers or has extra ones.
+func columnOrder(headers, other []string) ([]int, error) {
+	index := make(map[string]int, len(other))
+	for i, header := range other {
+		index[header] = i
+	}
+
+	var missing, extra []string
+	order := make([]int, len(headers))
+	for i, header := range headers {
+		col, ok := index[header]
+		if !ok {
+			missing = append(missing, header)
+			continue
+		}
+		order[i] = col
+		delete(index, header)
+	}
+	for _, header := range other {
+		if _, ok := index[header]; ok {
+			extra = append(extra, header)
+		}
+	}
+
+	var problems []string
+	if len(missing) > 0 {
+		problems = append(problems, "missing columns "+strings.Join(missing, ", "))
+	}
+	if len(extra) > 0 {
+		problems = append(problems, "unexpected columns "+strings.Join(extra, ", "))
+	}
+	if len(problems) > 0 {
+		return nil, fmt.Errorf("%w: %s", ErrHeaderMismatch, strings.Join(problems, "; "))
+	}
+	return order, nil
+}
@@ -0,0 +1,59 @@
+package xlsx_utilities
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConcat(t *testing.T) {
+	north := NewExcelData[person]([]string{"Name", "Age"})
+	north.AddRow([]interface{}{"Alice", 30})
+	south := NewExcelData[person]([]string{"Age", "Name"})
+	south.AddRow([]interface{}{25, "Bob"})
+
+	t.Run("Columns rearranged", func(t *testing.T) {
+		combined, err := Concat(north, south)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"Name", "Age"}, combined.Headers)
+		assert.Equal(t, [][]interface{}{{"Alice", 30}, {"Bob", 25}}, combined.Rows)
+		assert.Empty(t, combined.Provenance)
+	})
+
+	t.Run("Provenance", func(t *testing.T) {
+		tracked := south.Clone()
+		assert.NoError(t, tracked.SetProvenance(0, "Age", ProvenanceDefaulted))
+
+		combined, err := Concat(north, tracked)
+		assert.NoError(t, err)
+		assert.Equal(t, [][]Provenance{{ProvenanceUser, ProvenanceUser}, {ProvenanceUser, ProvenanceDefaulted}}, combined.Provenance)
+	})
+
+	t.Run("Mismatched headers", func(t *testing.T) {
+		other := NewExcelData[person]([]string{"Name", "Email"})
+		_, err := Concat(north, other)
+		assert.EqualError(t, err, "dataset 2: headers do not match: missing columns Age; unexpected columns Email")
+
+		_, err = Concat[person]()
+		assert.EqualError(t, err, "nothing to concatenate")
+	})
+
+	t.Run("Files", func(t *testing.T) {
+		dir := t.TempDir()
+		first, second, third := filepath.Join(dir, "north.xlsx"), filepath.Join(dir, "south.xlsx"), filepath.Join(dir, "other.xlsx")
+		assert.NoError(t, north.Save(first))
+		assert.NoError(t, south.Save(second))
+
+		other := NewExcelData[person]([]string{"Name"})
+		other.AddRow([]interface{}{"Carol"})
+		assert.NoError(t, other.Save(third))
+
+		merged, err := MergeExcelFiles[person](first, second)
+		assert.NoError(t, err)
+		assert.Equal(t, []person{{Name: "Alice", Age: 30}, {Name: "Bob", Age: 25}}, merged.ToStruct().Data)
+
+		_, err = MergeExcelFiles[person](first, third)
+		assert.EqualError(t, err, "'"+third+"': headers do not match: missing columns Age")
+	})
+}
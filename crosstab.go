@@ -0,0 +1,64 @@
+package xlsx_utilities
+
+import "fmt"
+
+// CrossTab reshapes the data into a cross tabulation baked into cells: one row per distinct value of the
+// rows column and one column per distinct value of the columns column, both in order of first appearance,
+// holding the aggregation of the values column. Empty keys are labelled "(blank)" and combinations
+// without rows are left empty. Unlike WithPivotTable, the result is plain data that can be exported anywhere.
+func (ed *ExcelData[T]) CrossTab(rows, columns, values string, fn AggFunc) (*ExcelData[T], error) {
+	if !fn.valid() {
+		return nil, fmt.Errorf("unknown aggregation '%s'", fn)
+	}
+
+	cols := make([]int, 3)
+	for i, header := range []string{rows, columns, values} {
+		if cols[i] = indexOf(ed.Headers, header); cols[i] == -1 {
+			return nil, fmt.Errorf("unknown cross tab column '%s'", header)
+		}
+	}
+
+	var rowKeys, colKeys []string
+	rowIndex, colIndex := make(map[string]int), make(map[string]int)
+	cells := make(map[[2]int]*aggState)
+	for r, row := range ed.Rows {
+		rk, ck := crossTabKey(row, cols[0]), crossTabKey(row, cols[1])
+		if _, ok := rowIndex[rk]; !ok {
+			rowIndex[rk] = len(rowKeys)
+			rowKeys = append(rowKeys, rk)
+		}
+		if _, ok := colIndex[ck]; !ok {
+			colIndex[ck] = len(colKeys)
+			colKeys = append(colKeys, ck)
+		}
+
+		at := [2]int{rowIndex[rk], colIndex[ck]}
+		if cells[at] == nil {
+			cells[at] = &aggState{}
+		}
+		if err := cells[at].add(row, cols[2], fn); err != nil {
+			return nil, fmt.Errorf("row %d, column '%s': %w", r, values, err)
+		}
+	}
+
+	result := ed.view(make([][]interface{}, len(rowKeys)))
+	result.Headers = append([]string{rows}, colKeys...)
+	for r, rk := range rowKeys {
+		result.Rows[r] = make([]interface{}, len(colKeys)+1)
+		result.Rows[r][0] = rk
+		for c := range colKeys {
+			if state := cells[[2]int{r, c}]; state != nil {
+				result.Rows[r][c+1] = state.result(fn)
+			}
+		}
+	}
+	return result, nil
+}
+
+// crossTabKey returns the text of the key cell, or "(blank)" when it is empty
+func crossTabKey(row []interface{}, col int) string {
+	if value := cellAt(row, col); !isEmptyCell(value) {
+		return cellText(value)
+	}
+	return blankGroup
+}
@@ -0,0 +1,42 @@
+package xlsx_utilities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCrossTab(t *testing.T) {
+	excelData := NewExcelData[struct{}]([]string{"Region", "Quarter", "Amount"})
+	excelData.Rows = [][]interface{}{
+		{"North", "Q1", 10},
+		{"South", "Q2", 4},
+		{"North", "Q2", 6},
+		{"North", "Q1", 5},
+		{"", "Q1", 1},
+	}
+
+	crossTab, err := excelData.CrossTab("Region", "Quarter", "Amount", AggSum)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Region", "Q1", "Q2"}, crossTab.Headers)
+	assert.Equal(t, [][]interface{}{
+		{"North", 15.0, 6.0},
+		{"South", nil, 4.0},
+		{"(blank)", 1.0, nil},
+	}, crossTab.Rows)
+
+	t.Run("Pipe step", func(t *testing.T) {
+		result, err := excelData.Pipe(CrossTab("Quarter", "Region", "Amount", AggCount))
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"Quarter", "North", "South", "(blank)"}, result.Headers)
+		assert.Equal(t, []interface{}{"Q1", 2, nil, 1}, result.Rows[0])
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		_, err := excelData.CrossTab("Region", "Month", "Amount", AggSum)
+		assert.ErrorContains(t, err, "unknown cross tab column 'Month'")
+
+		_, err = excelData.CrossTab("Region", "Quarter", "Amount", "Median")
+		assert.ErrorContains(t, err, "unknown aggregation 'Median'")
+	})
+}
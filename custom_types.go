@@ -1,7 +1,10 @@
 package xlsx_utilities
 
 import (
+	"encoding/json"
 	"fmt"
+	"net"
+	"net/url"
 	"reflect"
 	"time"
 )
@@ -28,6 +31,26 @@ func RegisterTypeParser(t reflect.Type, parser CustomTypeParser) {
 	TypeParsers[t] = parser
 }
 
+// RegisterConverter registers the export converter and import parser of type T in one call, without
+// reflect.Type values or interface assertions. Empty cells leave fields of type T at their zero value
+// instead of being passed to from.
+func RegisterConverter[T any](to func(T) (string, error), from func(string) (T, error)) {
+	t := reflect.TypeOf((*T)(nil)).Elem()
+	RegisterTypeConverter(t, func(i interface{}) (string, error) {
+		value, ok := i.(T)
+		if !ok {
+			return "", fmt.Errorf("expected %v, got %T", t, i)
+		}
+		return to(value)
+	})
+	RegisterTypeParser(t, func(s string) (interface{}, error) {
+		if s == "" {
+			return nil, nil
+		}
+		return from(s)
+	})
+}
+
 // init function to register built-in custom type handlers
 func init() {
 	// Register time.Time handlers
@@ -39,7 +62,101 @@ func init() {
 		return t.Format(time.RFC3339), nil
 	})
 
-	RegisterTypeParser(reflect.TypeOf(time.Time{}), func(s string) (interface{}, error) {
-		return time.Parse(time.RFC3339, s)
+	// time.Time has no built-in parser: setField parses RFC 3339 and falls back to common date layouts
+}
+
+// RegisterCommonTypes registers converters and parsers for commonly used standard library types:
+// time.Duration, net.IP, url.URL and json.RawMessage. Empty cells leave the field at its zero value.
+func RegisterCommonTypes() {
+	RegisterTypeConverter(reflect.TypeOf(time.Duration(0)), func(i interface{}) (string, error) {
+		d, ok := i.(time.Duration)
+		if !ok {
+			return "", fmt.Errorf("expected time.Duration, got %T", i)
+		}
+		return d.String(), nil
+	})
+	RegisterTypeParser(reflect.TypeOf(time.Duration(0)), func(s string) (interface{}, error) {
+		if s == "" {
+			return nil, nil
+		}
+		return time.ParseDuration(s)
+	})
+
+	RegisterTypeConverter(reflect.TypeOf(net.IP{}), func(i interface{}) (string, error) {
+		ip, ok := i.(net.IP)
+		if !ok {
+			return "", fmt.Errorf("expected net.IP, got %T", i)
+		}
+		if ip == nil {
+			return "", nil
+		}
+		return ip.String(), nil
+	})
+	RegisterTypeParser(reflect.TypeOf(net.IP{}), func(s string) (interface{}, error) {
+		if s == "" {
+			return nil, nil
+		}
+		ip := net.ParseIP(s)
+		if ip == nil {
+			return nil, fmt.Errorf("invalid IP address: %s", s)
+		}
+		return ip, nil
 	})
+
+	RegisterTypeConverter(reflect.TypeOf(url.URL{}), func(i interface{}) (string, error) {
+		u, ok := i.(url.URL)
+		if !ok {
+			return "", fmt.Errorf("expected url.URL, got %T", i)
+		}
+		return u.String(), nil
+	})
+	RegisterTypeParser(reflect.TypeOf(url.URL{}), func(s string) (interface{}, error) {
+		if s == "" {
+			return nil, nil
+		}
+		u, err := url.Parse(s)
+		if err != nil {
+			return nil, err
+		}
+		return *u, nil
+	})
+
+	RegisterTypeConverter(reflect.TypeOf(json.RawMessage{}), func(i interface{}) (string, error) {
+		raw, ok := i.(json.RawMessage)
+		if !ok {
+			return "", fmt.Errorf("expected json.RawMessage, got %T", i)
+		}
+		return string(raw), nil
+	})
+	RegisterTypeParser(reflect.TypeOf(json.RawMessage{}), func(s string) (interface{}, error) {
+		if s == "" {
+			return nil, nil
+		}
+		if !json.Valid([]byte(s)) {
+			return nil, fmt.Errorf("invalid JSON: %s", s)
+		}
+		return json.RawMessage(s), nil
+	})
+}
+
+// RegisterTextTypes registers converters and parsers for the types of the given sample values using their
+// encoding.TextMarshaler and encoding.TextUnmarshaler implementations. This covers most third-party value
+// types without extra dependencies, e.g. uuid.UUID, decimal.Decimal or civil.Date:
+//
+//	RegisterTextTypes(uuid.UUID{}, decimal.Decimal{}, civil.Date{})
+func RegisterTextTypes(samples ...interface{}) error {
+	for _, sample := range samples {
+		t := reflect.TypeOf(sample)
+		if t == nil {
+			return fmt.Errorf("cannot register nil sample")
+		}
+		if !t.Implements(textMarshalerType) || !reflect.PointerTo(t).Implements(textUnmarshalerType) {
+			return fmt.Errorf("type %v does not implement encoding.TextMarshaler and encoding.TextUnmarshaler", t)
+		}
+
+		RegisterTypeConverter(t, textConverter(t))
+		RegisterTypeParser(t, textParser(t))
+	}
+
+	return nil
 }
@@ -1,7 +1,10 @@
 package xlsx_utilities
 
 import (
+	"encoding/json"
 	"fmt"
+	"net"
+	"net/url"
 	"os"
 	"reflect"
 	"testing"
@@ -138,3 +141,93 @@ func TestXlsxUtilities(t *testing.T) {
 		assert.NotContains(t, excelData.Headers, "id")
 	})
 }
+
+// textID is a stand-in for third-party value types such as uuid.UUID
+type textID struct {
+	prefix string
+	number int
+}
+
+func (id textID) MarshalText() ([]byte, error) {
+	return []byte(fmt.Sprintf("%s-%d", id.prefix, id.number)), nil
+}
+
+func (id *textID) UnmarshalText(text []byte) error {
+	_, err := fmt.Sscanf(string(text), "%1s-%d", &id.prefix, &id.number)
+	return err
+}
+
+func TestRegisterCommonTypes(t *testing.T) {
+	RegisterCommonTypes()
+	assert.NoError(t, RegisterTextTypes(textID{}))
+
+	type server struct {
+		Name    string
+		IP      net.IP
+		Home    url.URL
+		Timeout time.Duration
+		Meta    json.RawMessage
+		Ref     textID
+	}
+
+	home, _ := url.Parse("https://example.com/status")
+	data := []*server{
+		{
+			Name:    "web-1",
+			IP:      net.ParseIP("10.0.0.1"),
+			Home:    *home,
+			Timeout: 90 * time.Second,
+			Meta:    json.RawMessage(`{"zone":"a"}`),
+			Ref:     textID{prefix: "S", number: 7},
+		},
+	}
+
+	excelData, err := FromStruct(data)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Name", "IP", "Home", "Timeout", "Meta", "Ref"}, excelData.Headers)
+	assert.Equal(t, []interface{}{"web-1", "10.0.0.1", "https://example.com/status", "1m30s", `{"zone":"a"}`, "S-7"}, excelData.Rows[0])
+
+	result := excelData.ToStruct()
+	assert.Empty(t, result.Errors)
+	assert.Len(t, result.Data, 1)
+	assert.True(t, data[0].IP.Equal(result.Data[0].IP))
+	assert.Equal(t, data[0].Home.String(), result.Data[0].Home.String())
+	assert.Equal(t, data[0].Timeout, result.Data[0].Timeout)
+	assert.JSONEq(t, string(data[0].Meta), string(result.Data[0].Meta))
+	assert.Equal(t, data[0].Ref, result.Data[0].Ref)
+
+	assert.Error(t, RegisterTextTypes(42))
+}
+
+// cents is a money amount registered with RegisterConverter
+type cents int64
+
+func TestRegisterConverter(t *testing.T) {
+	RegisterConverter(func(c cents) (string, error) {
+		return fmt.Sprintf("%d.%02d", c/100, c%100), nil
+	}, func(s string) (cents, error) {
+		var whole, fraction int64
+		if _, err := fmt.Sscanf(s, "%d.%02d", &whole, &fraction); err != nil {
+			return 0, err
+		}
+		return cents(whole*100 + fraction), nil
+	})
+
+	type payment struct {
+		Payer  string
+		Amount cents
+	}
+	data := []payment{{"Alice", 1250}, {"Bob", 7}}
+
+	excelData, err := FromStruct(data)
+	assert.NoError(t, err)
+	assert.Equal(t, []interface{}{"Alice", "12.50"}, excelData.Rows[0])
+	excelData.AddRow([]interface{}{"Carol", "lots"})
+	excelData.AddRow([]interface{}{"Dave", ""})
+
+	result := excelData.ToStruct()
+	assert.Equal(t, append(data, payment{"Dave", 0}), result.Data)
+	if assert.Len(t, result.Errors, 1) {
+		assert.Equal(t, "Amount", result.Errors[0].Header)
+	}
+}
@@ -0,0 +1,76 @@
+package xlsx_utilities
+
+import "fmt"
+
+// DedupePolicy decides which of the rows sharing a key is kept
+type DedupePolicy int
+
+const (
+	// KeepFirst keeps the first row of each key
+	KeepFirst DedupePolicy = iota
+	// KeepLast keeps the last row of each key, at the position of that row
+	KeepLast
+)
+
+// Duplicate reports a row dropped by Dedupe
+type Duplicate struct {
+	// RowIndex is the 0-based index of the dropped row
+	RowIndex int
+	// KeptIndex is the 0-based index of the row kept for the same key
+	KeptIndex int
+	// Key holds the values of the key columns
+	Key []interface{}
+}
+
+// Dedupe drops rows whose key columns repeat those of an earlier row, keeping the first.
+// Without keys, rows are compared on all columns. Values are compared by their text.
+func (ed *ExcelData[T]) Dedupe(keys ...string) (*ExcelData[T], []Duplicate, error) {
+	return ed.DedupeWith(KeepFirst, keys...)
+}
+
+// DedupeWith drops rows sharing the same key columns, keeping one row per key according to the policy.
+// It returns a dataset with the remaining rows in their original order and the dropped rows.
+func (ed *ExcelData[T]) DedupeWith(policy DedupePolicy, keys ...string) (*ExcelData[T], []Duplicate, error) {
+	keyCols := make([]int, len(keys))
+	for i, key := range keys {
+		if keyCols[i] = indexOf(ed.Headers, key); keyCols[i] == -1 {
+			return nil, nil, fmt.Errorf("%w: unknown key column '%s'", ErrHeaderMismatch, key)
+		}
+	}
+	if len(keys) == 0 {
+		for col := range ed.Headers {
+			keyCols = append(keyCols, col)
+		}
+	}
+
+	// index of the row kept for each key
+	kept := make(map[string]int)
+	for r, row := range ed.Rows {
+		key := rowKey(row, keyCols)
+		if _, ok := kept[key]; !ok || policy == KeepLast {
+			kept[key] = r
+		}
+	}
+
+	result := ed.view(nil)
+	var duplicates []Duplicate
+	for r, row := range ed.Rows {
+		keep := kept[rowKey(row, keyCols)]
+		if keep != r {
+			values := make([]interface{}, len(keyCols))
+			for i, col := range keyCols {
+				values[i] = cellAt(row, col)
+			}
+			duplicates = append(duplicates, Duplicate{RowIndex: r, KeptIndex: keep, Key: values})
+			continue
+		}
+
+		result.Rows = append(result.Rows, row)
+		ed.keepLine(result, r)
+		if len(ed.Provenance) > 0 {
+			result.Provenance = append(result.Provenance, ed.rowProvenance(r))
+		}
+	}
+
+	return result, duplicates, nil
+}
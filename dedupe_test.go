@@ -0,0 +1,46 @@
+package xlsx_utilities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDedupe(t *testing.T) {
+	excelData := NewExcelData[person]([]string{"Name", "Age"})
+	excelData.AddRow([]interface{}{"Alice", 30})
+	excelData.AddRow([]interface{}{"Bob", 25})
+	excelData.AddRow([]interface{}{"Alice", 31})
+	excelData.AddRow([]interface{}{"Bob", 25.0})
+
+	t.Run("First wins", func(t *testing.T) {
+		deduped, duplicates, err := excelData.Dedupe("Name")
+		assert.NoError(t, err)
+		assert.Equal(t, [][]interface{}{{"Alice", 30}, {"Bob", 25}}, deduped.Rows)
+		assert.Equal(t, []Duplicate{
+			{RowIndex: 2, KeptIndex: 0, Key: []interface{}{"Alice"}},
+			{RowIndex: 3, KeptIndex: 1, Key: []interface{}{"Bob"}},
+		}, duplicates)
+	})
+
+	t.Run("Last wins", func(t *testing.T) {
+		deduped, duplicates, err := excelData.DedupeWith(KeepLast, "Name")
+		assert.NoError(t, err)
+		assert.Equal(t, [][]interface{}{{"Alice", 31}, {"Bob", 25.0}}, deduped.Rows)
+		assert.Equal(t, 0, duplicates[0].RowIndex)
+		assert.Equal(t, 2, duplicates[0].KeptIndex)
+	})
+
+	t.Run("Whole rows", func(t *testing.T) {
+		deduped, duplicates, err := excelData.Dedupe()
+		assert.NoError(t, err)
+		assert.Len(t, deduped.Rows, 3)
+		assert.Equal(t, []Duplicate{{RowIndex: 3, KeptIndex: 1, Key: []interface{}{"Bob", 25.0}}}, duplicates)
+	})
+
+	t.Run("Unknown key", func(t *testing.T) {
+		_, _, err := excelData.Dedupe("Email")
+		assert.ErrorIs(t, err, ErrHeaderMismatch)
+		assert.EqualError(t, err, "headers do not match: unknown key column 'Email'")
+	})
+}
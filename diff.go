@@ -0,0 +1,229 @@
+package xlsx_utilities
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// Change kinds written to the first column of a diff workbook
+const (
+	ChangeAdded   = "Added"
+	ChangeRemoved = "Removed"
+	ChangeChanged = "Changed"
+)
+
+// Fill colors of the rows and cells in a diff workbook
+const (
+	diffAddedColor   = "C6EFCE"
+	diffRemovedColor = "FFC7CE"
+	diffChangedColor = "FFEB9C"
+)
+
+// RowChange is a row present in both datasets with different values
+type RowChange struct {
+	Before []interface{}
+	After  []interface{}
+	// Columns are the headers of the values that differ
+	Columns []string
+}
+
+// DiffResult lists the differences between two datasets, with rows in the column order of Headers
+type DiffResult[T comparable] struct {
+	Headers []string
+	Added   [][]interface{}
+	Removed [][]interface{}
+	Changed []RowChange
+}
+
+// Diff compares two datasets with the same columns, matching rows on the key columns.
+// Values are compared by their text, so 30 and 30.0 read from different files do not count as a change.
+func Diff[T comparable](before, after *ExcelData[T], keys ...string) (*DiffResult[T], error) {
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("diff needs at least one key column")
+	}
+
+	order, err := columnOrder(before.Headers, after.Headers)
+	if err != nil {
+		return nil, err
+	}
+
+	keyCols := make([]int, len(keys))
+	for i, key := range keys {
+		if keyCols[i] = indexOf(before.Headers, key); keyCols[i] == -1 {
+			return nil, fmt.Errorf("%w: unknown key column '%s'", ErrHeaderMismatch, key)
+		}
+	}
+
+	// rows of after, rearranged to the column order of before
+	rearranged := make([][]interface{}, len(after.Rows))
+	for r, row := range after.Rows {
+		rearranged[r] = make([]interface{}, len(order))
+		for i, col := range order {
+			if col < len(row) {
+				rearranged[r][i] = row[col]
+			}
+		}
+	}
+
+	beforeIndex, err := indexRows(before.Rows, keyCols)
+	if err != nil {
+		return nil, fmt.Errorf("before: %w", err)
+	}
+	afterIndex, err := indexRows(rearranged, keyCols)
+	if err != nil {
+		return nil, fmt.Errorf("after: %w", err)
+	}
+
+	result := &DiffResult[T]{Headers: before.Headers}
+	for _, row := range before.Rows {
+		if _, ok := afterIndex[rowKey(row, keyCols)]; !ok {
+			result.Removed = append(result.Removed, row)
+		}
+	}
+	for _, row := range rearranged {
+		r, ok := beforeIndex[rowKey(row, keyCols)]
+		if !ok {
+			result.Added = append(result.Added, row)
+			continue
+		}
+
+		old := before.Rows[r]
+		var changed []string
+		for col, header := range before.Headers {
+			if cellText(cellAt(old, col)) != cellText(cellAt(row, col)) {
+				changed = append(changed, header)
+			}
+		}
+		if len(changed) > 0 {
+			result.Changed = append(result.Changed, RowChange{Before: old, After: row, Columns: changed})
+		}
+	}
+
+	return result, nil
+}
+
+// DiffExcelFiles reads the default sheet of both files and compares them with Diff
+func DiffExcelFiles[T comparable](before, after string, keys ...string) (*DiffResult[T], error) {
+	beforeData, err := FromExcel[T](before)
+	if err != nil {
+		return nil, fmt.Errorf("error reading '%s': %w", before, err)
+	}
+	afterData, err := FromExcel[T](after)
+	if err != nil {
+		return nil, fmt.Errorf("error reading '%s': %w", after, err)
+	}
+	return Diff(beforeData, afterData, keys...)
+}
+
+// Empty reports whether the datasets had no differences
+func (d *DiffResult[T]) Empty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Changed) == 0
+}
+
+// ToWorkbook writes the differences to a workbook with a leading "Change" column.
+// Added rows are green and removed rows red; changed rows show the new values with the
+// changed cells in yellow and a comment holding the previous value.
+func (d *DiffResult[T]) ToWorkbook(opts ...Option) (*excelize.File, error) {
+	ed := NewExcelData[T](append([]string{"Change"}, d.Headers...))
+	for _, row := range d.Added {
+		ed.Rows = append(ed.Rows, append([]interface{}{ChangeAdded}, row...))
+	}
+	for _, row := range d.Removed {
+		ed.Rows = append(ed.Rows, append([]interface{}{ChangeRemoved}, row...))
+	}
+	for _, change := range d.Changed {
+		ed.Rows = append(ed.Rows, append([]interface{}{ChangeChanged}, change.After...))
+	}
+
+	styles, err := ed.config(opts...).styleSheet()
+	if err != nil {
+		return nil, err
+	}
+	opts = append(opts, WithStyles(diffStyles(styles)))
+
+	f, err := ed.ToWorkbook(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := d.markChanges(f, ed.config(opts...), len(d.Added)+len(d.Removed)); err != nil {
+		f.Close()
+		return nil, err
+	}
+	return f, nil
+}
+
+// diffStyles returns the style sheet of the caller with the header made bold and the added and removed rows
+// filled on top of its own row styles
+func diffStyles(styles *StyleSheet) StyleSheet {
+	var ss StyleSheet
+	if styles != nil {
+		ss = *styles
+	}
+	ss.Header = overlay(&Style{Bold: true}, ss.Header)
+
+	rowStyle := ss.Row
+	ss.Row = func(rowIndex int, row []interface{}) *Style {
+		var style *Style
+		if rowStyle != nil {
+			style = rowStyle(rowIndex, row)
+		}
+		switch row[0] {
+		case ChangeAdded:
+			return overlay(style, &Style{FillColor: diffAddedColor})
+		case ChangeRemoved:
+			return overlay(style, &Style{FillColor: diffRemovedColor})
+		}
+		return style
+	}
+	return ss
+}
+
+// markChanges highlights the changed cells of the changed rows, which start after offset data rows
+func (d *DiffResult[T]) markChanges(f *excelize.File, cfg *config, offset int) error {
+	layout, err := exportLayout(cfg, len(d.Headers)+1, 0)
+	if err != nil {
+		return err
+	}
+	if layout.HeaderRow && cfg.groupedHeaders {
+		layout = layout.belowGroupRow()
+	}
+
+	highlight := newRestyler(f, cfg.sheet, func(s *excelize.Style) {
+		s.Fill = excelize.Fill{Type: "pattern", Pattern: 1, Color: []string{diffChangedColor}}
+	})
+
+	for r, change := range d.Changed {
+		for _, header := range change.Columns {
+			i := indexOf(d.Headers, header)
+			cell := layout.cell(i+1, layout.firstDataRow()+offset+r)
+			if err := highlight.restyle(cell); err != nil {
+				return err
+			}
+			comment := excelize.Comment{
+				Cell:   cell,
+				Author: "Diff",
+				Text:   "was: " + cellText(cellAt(change.Before, i)),
+			}
+			if err := f.AddComment(cfg.sheet, comment); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// indexRows maps the key of each row to its index, failing on duplicate keys
+func indexRows(rows [][]interface{}, keyCols []int) (map[string]int, error) {
+	index := make(map[string]int, len(rows))
+	for r, row := range rows {
+		key := rowKey(row, keyCols)
+		if _, ok := index[key]; ok {
+			return nil, fmt.Errorf("duplicate key '%s'", strings.ReplaceAll(key, "\x00", ", "))
+		}
+		index[key] = r
+	}
+	return index, nil
+}
@@ -0,0 +1,137 @@
+package xlsx_utilities
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type balance struct {
+	Account string
+	Branch  string
+	Amount  float64
+}
+
+func TestDiff(t *testing.T) {
+	before, err := FromStruct([]balance{
+		{Account: "A1", Branch: "North", Amount: 100},
+		{Account: "A2", Branch: "North", Amount: 50},
+		{Account: "A3", Branch: "South", Amount: 75},
+	})
+	assert.NoError(t, err)
+
+	after := NewExcelData[balance]([]string{"Amount", "Branch", "Account"})
+	after.AddRow([]interface{}{100, "North", "A1"})
+	after.AddRow([]interface{}{80.5, "North", "A2"})
+	after.AddRow([]interface{}{20.0, "East", "A4"})
+
+	diff, err := Diff(before, after, "Account")
+	assert.NoError(t, err)
+
+	t.Run("Report", func(t *testing.T) {
+		assert.False(t, diff.Empty())
+		assert.Equal(t, [][]interface{}{{"A4", "East", 20.0}}, diff.Added)
+		assert.Equal(t, [][]interface{}{{"A3", "South", 75.0}}, diff.Removed)
+		assert.Equal(t, []RowChange{{
+			Before:  []interface{}{"A2", "North", 50.0},
+			After:   []interface{}{"A2", "North", 80.5},
+			Columns: []string{"Amount"},
+		}}, diff.Changed)
+
+		same, err := Diff(before, before, "Account", "Branch")
+		assert.NoError(t, err)
+		assert.True(t, same.Empty())
+	})
+
+	t.Run("Workbook", func(t *testing.T) {
+		f, err := diff.ToWorkbook()
+		assert.NoError(t, err)
+		defer f.Close()
+
+		rows, err := f.GetRows("Sheet1")
+		assert.NoError(t, err)
+		assert.Equal(t, [][]string{
+			{"Change", "Account", "Branch", "Amount"},
+			{"Added", "A4", "East", "20"},
+			{"Removed", "A3", "South", "75"},
+			{"Changed", "A2", "North", "80.5"},
+		}, rows)
+
+		comments, err := f.GetComments("Sheet1")
+		assert.NoError(t, err)
+		assert.Len(t, comments, 1)
+		assert.Equal(t, "D4", comments[0].Cell)
+		assert.Equal(t, "was: 50", comments[0].Text)
+
+		id, err := f.GetCellStyle("Sheet1", "D4")
+		assert.NoError(t, err)
+		style, err := f.GetStyle(id)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{diffChangedColor}, style.Fill.Color)
+	})
+
+	t.Run("Workbook with caller styles", func(t *testing.T) {
+		styles := StyleSheet{Header: &Style{FontColor: "1F4E79"}, Columns: map[string]*Style{"Amount": {NumFmt: "0.00"}}}
+		f, err := diff.ToWorkbook(WithStyles(styles))
+		assert.NoError(t, err)
+		defer f.Close()
+
+		id, err := f.GetCellStyle("Sheet1", "A1")
+		assert.NoError(t, err)
+		style, err := f.GetStyle(id)
+		assert.NoError(t, err)
+		assert.True(t, style.Font.Bold)
+		assert.Equal(t, "1F4E79", style.Font.Color)
+
+		id, err = f.GetCellStyle("Sheet1", "D2")
+		assert.NoError(t, err)
+		style, err = f.GetStyle(id)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{diffAddedColor}, style.Fill.Color)
+		assert.Equal(t, "0.00", *style.CustomNumFmt)
+	})
+
+	t.Run("Transposed workbook", func(t *testing.T) {
+		moved := NewExcelData[balance]([]string{"Account", "Branch", "Amount"})
+		moved.AddRow([]interface{}{"A1", "East", 100})
+		moved.AddRow([]interface{}{"A2", "North", 50})
+		moved.AddRow([]interface{}{"A3", "South", 75})
+		diff, err := Diff(before, moved, "Account")
+		assert.NoError(t, err)
+
+		f, err := diff.ToWorkbook(WithTransposed())
+		assert.NoError(t, err)
+		defer f.Close()
+
+		comments, err := f.GetComments("Sheet1")
+		assert.NoError(t, err)
+		assert.Len(t, comments, 1)
+		assert.Equal(t, "B3", comments[0].Cell)
+		assert.Equal(t, "was: North", comments[0].Text)
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		_, err := Diff(before, after)
+		assert.EqualError(t, err, "diff needs at least one key column")
+
+		_, err = Diff(before, after, "Customer")
+		assert.ErrorIs(t, err, ErrHeaderMismatch)
+		assert.EqualError(t, err, "headers do not match: unknown key column 'Customer'")
+
+		_, err = Diff(before, after, "Branch")
+		assert.EqualError(t, err, "before: duplicate key 'North'")
+	})
+
+	t.Run("Files", func(t *testing.T) {
+		dir := t.TempDir()
+		assert.NoError(t, before.Save(filepath.Join(dir, "before.xlsx")))
+		assert.NoError(t, after.Save(filepath.Join(dir, "after.xlsx")))
+
+		fromFiles, err := DiffExcelFiles[balance](filepath.Join(dir, "before.xlsx"), filepath.Join(dir, "after.xlsx"), "Account")
+		assert.NoError(t, err)
+		assert.Len(t, fromFiles.Added, 1)
+		assert.Len(t, fromFiles.Removed, 1)
+		assert.Len(t, fromFiles.Changed, 1)
+	})
+}
@@ -0,0 +1,96 @@
+package xlsx_utilities
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// enumMap translates between the codes of a field and their labels, by the text of the code
+type enumMap struct {
+	labels map[string]string // code → label
+	codes  map[string]string // label → code
+}
+
+// add maps the code to the label in both directions
+func (m *enumMap) add(code, label string) {
+	m.labels[code] = label
+	m.codes[label] = code
+}
+
+// label returns the label of a code value, or the value itself when it has no label
+func (m *enumMap) label(value interface{}) interface{} {
+	if label, ok := m.labels[fmt.Sprint(value)]; ok {
+		return label
+	}
+	return value
+}
+
+// code returns the code text of a label cell, matching labels case-insensitively,
+// or the value itself when it is no label
+func (m *enumMap) code(value interface{}) interface{} {
+	text, ok := value.(string)
+	if !ok {
+		return value
+	}
+	text = strings.TrimSpace(text)
+	if code, ok := m.codes[text]; ok {
+		return code
+	}
+	for label, code := range m.codes {
+		if strings.EqualFold(label, text) {
+			return code
+		}
+	}
+	return value
+}
+
+// enumLabels maps types to the labels registered with RegisterEnumLabels
+var enumLabels = map[reflect.Type]*enumMap{}
+
+// RegisterEnumLabels registers the labels of the values of an enum type, exported instead of the
+// codes and translated back into codes on import, e.g. {StatusActive: "Active", StatusInactive: "Inactive"}
+func RegisterEnumLabels[E comparable](labels map[E]string) {
+	m := &enumMap{labels: map[string]string{}, codes: map[string]string{}}
+	for code, label := range labels {
+		m.add(fmt.Sprint(code), label)
+	}
+	enumLabels[reflect.TypeOf((*E)(nil)).Elem()] = m
+
+	// plans compiled before hold the labels found then
+	fieldPlans.Range(func(key, _ interface{}) bool {
+		fieldPlans.Delete(key)
+		return true
+	})
+}
+
+// tagEnums caches the enum maps parsed from map tag options, by option text
+var tagEnums sync.Map
+
+// enumFor returns the labels of a field from its map tag option, e.g. `xlsx:"Status,map=1:Active;2:Inactive"`,
+// or registered for its type; nil when it has none
+func enumFor(field reflect.StructField) (*enumMap, error) {
+	if option, ok := tagOption(field, "map"); ok {
+		if cached, ok := tagEnums.Load(option); ok {
+			return cached.(*enumMap), nil
+		}
+
+		m := &enumMap{labels: map[string]string{}, codes: map[string]string{}}
+		for _, pair := range strings.Split(option, ";") {
+			code, label, ok := strings.Cut(pair, ":")
+			if !ok || code == "" {
+				return nil, fmt.Errorf("invalid map %q of field %s", option, field.Name)
+			}
+			m.add(code, label)
+		}
+		tagEnums.Store(option, m)
+		return m, nil
+	}
+
+	t := field.Type
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return enumLabels[t], nil
+}
@@ -0,0 +1,69 @@
+package xlsx_utilities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type enumPriority int
+
+const (
+	enumLow enumPriority = iota + 1
+	enumHigh
+)
+
+type enumSize int
+
+func TestEnumLabels(t *testing.T) {
+	RegisterEnumLabels(map[enumPriority]string{enumLow: "Low", enumHigh: "High"})
+
+	type ticket struct {
+		ID       int
+		Status   int `xlsx:"Status,map=1:Active;2:Inactive"`
+		Priority enumPriority
+		Backup   *enumPriority
+	}
+
+	high := enumHigh
+	excelData, err := FromStruct([]ticket{{ID: 1, Status: 1, Priority: enumLow, Backup: &high}, {ID: 2, Status: 3, Priority: enumHigh}})
+	assert.NoError(t, err)
+	assert.Equal(t, []interface{}{1, "Active", "Low", "High"}, excelData.Rows[0])
+	assert.Equal(t, []interface{}{2, 3, "High", nil}, excelData.Rows[1])
+
+	excelData.Rows[1][1] = " inactive "
+	result := excelData.ToStruct()
+	assert.Empty(t, result.Errors)
+	assert.Equal(t, []ticket{{ID: 1, Status: 1, Priority: enumLow, Backup: &high}, {ID: 2, Status: 2, Priority: enumHigh}}, result.Data)
+
+	t.Run("Codes are still accepted", func(t *testing.T) {
+		codes := NewExcelData[ticket]([]string{"Status", "Priority"})
+		codes.AddRow([]interface{}{"2", "1"})
+		result := codes.ToStruct()
+		assert.Empty(t, result.Errors)
+		assert.Equal(t, []ticket{{Status: 2, Priority: enumLow}}, result.Data)
+	})
+
+	t.Run("Registered after an import", func(t *testing.T) {
+		type shirt struct {
+			Size enumSize
+		}
+		shirts := NewExcelData[shirt]([]string{"Size"})
+		shirts.AddRow([]interface{}{"2"})
+		assert.Equal(t, []shirt{{Size: 2}}, shirts.ToStruct().Data)
+
+		RegisterEnumLabels(map[enumSize]string{1: "Small", 2: "Large"})
+		shirts.Rows[0][0] = "Large"
+		result := shirts.ToStruct()
+		assert.Empty(t, result.Errors)
+		assert.Equal(t, []shirt{{Size: 2}}, result.Data)
+	})
+
+	t.Run("Invalid map", func(t *testing.T) {
+		type invalid struct {
+			Status int `xlsx:",map=Active"`
+		}
+		_, err := FromStruct([]invalid{{Status: 1}})
+		assert.ErrorContains(t, err, `invalid map "Active" of field Status`)
+	})
+}
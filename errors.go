@@ -0,0 +1,47 @@
+package xlsx_utilities
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// Errors returned, possibly wrapped, by imports and conversions. Use errors.Is to check for them.
+var (
+	// ErrEmptyFile is returned when the sheet read has no header row or no data rows
+	ErrEmptyFile = errors.New("excel file is empty or has no data rows")
+
+	// ErrEmptyInput is returned when exporting an empty slice, whose headers are unknown
+	ErrEmptyInput = errors.New("input slice is empty")
+
+	// ErrHeaderMismatch is returned when the headers of a sheet or dataset are not the expected ones,
+	// or when a row or an operation refers to columns the dataset does not have
+	ErrHeaderMismatch = errors.New("headers do not match")
+
+	// ErrUnsupportedType is returned when a field has a type that cannot be set from a cell
+	ErrUnsupportedType = errors.New("unsupported type")
+
+	// ErrLimitExceeded is returned when a workbook read is larger than a configured upload limit
+	ErrLimitExceeded = errors.New("upload limit exceeded")
+
+	// ErrTypeMismatch is returned with WithStrictTypes when a cell holds a number for a text field or text for a number field
+	ErrTypeMismatch = errors.New("cell type does not match field type")
+)
+
+// ConversionError reports a cell value that cannot be converted to the type of its field.
+// It is found with errors.As in the Err of an ImportError.
+type ConversionError struct {
+	Value interface{}
+	Type  reflect.Type
+	Err   error
+}
+
+// Error returns a string representation of the ConversionError
+func (e *ConversionError) Error() string {
+	return fmt.Sprintf("cannot convert '%v' to type %v: %v", e.Value, e.Type, e.Err)
+}
+
+// Unwrap returns the underlying parse error
+func (e *ConversionError) Unwrap() error {
+	return e.Err
+}
@@ -0,0 +1,106 @@
+package xlsx_utilities
+
+import (
+	"errors"
+	"os"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/xuri/excelize/v2"
+)
+
+func TestErrors(t *testing.T) {
+	t.Run("Empty file", func(t *testing.T) {
+		filename := "test_errors_empty.xlsx"
+		defer os.Remove(filename)
+		assert.NoError(t, excelize.NewFile().SaveAs(filename))
+
+		_, err := FromExcelAllSheets[person](filename)
+		assert.ErrorIs(t, err, ErrEmptyFile)
+	})
+
+	t.Run("Header mismatch", func(t *testing.T) {
+		a := NewExcelData[person]([]string{"Name", "Age"})
+		b := NewExcelData[person]([]string{"Name"})
+		_, err := Concat(a, b)
+		assert.ErrorIs(t, err, ErrHeaderMismatch)
+	})
+
+	t.Run("Empty input", func(t *testing.T) {
+		_, err := FromStruct([]person{})
+		assert.ErrorIs(t, err, ErrEmptyInput)
+		_, err = FromMaps[struct{}](nil)
+		assert.ErrorIs(t, err, ErrEmptyInput)
+	})
+
+	t.Run("Row length", func(t *testing.T) {
+		err := NewExcelData[person]([]string{"Name", "Age"}).AddRow([]interface{}{"Alice"})
+		assert.ErrorIs(t, err, ErrHeaderMismatch)
+	})
+
+	t.Run("Unsupported type", func(t *testing.T) {
+		type withChannel struct {
+			Updates chan int
+		}
+		excelData := NewExcelData[withChannel]([]string{"Updates"})
+		excelData.AddRow([]interface{}{"x"})
+
+		result := excelData.ToStruct()
+		assert.Len(t, result.Errors, 1)
+		assert.ErrorIs(t, result.Errors[0], ErrUnsupportedType)
+	})
+
+	t.Run("Conversion error", func(t *testing.T) {
+		type event struct {
+			At time.Time
+		}
+		excelData := NewExcelData[event]([]string{"At"})
+		excelData.AddRow([]interface{}{"yesterday"})
+
+		result := excelData.ToStruct()
+		assert.Len(t, result.Errors, 1)
+
+		var conversion *ConversionError
+		assert.True(t, errors.As(result.Errors[0], &conversion))
+		assert.Equal(t, "yesterday", conversion.Value)
+		assert.Equal(t, reflect.TypeOf(time.Time{}), conversion.Type)
+
+		var parseErr *time.ParseError
+		assert.ErrorAs(t, result.Errors[0], &parseErr)
+	})
+}
+
+func TestImportErrorCell(t *testing.T) {
+	type event struct {
+		Name string
+		At   time.Time
+	}
+
+	newData := func(opts ...Option) *ExcelData[event] {
+		excelData := NewExcelData[event]([]string{"Name", "At"})
+		excelData.AddRow([]interface{}{"ok", "2024-03-01T00:00:00Z"})
+		excelData.AddRow([]interface{}{"bad", "yesterday"})
+		return excelData.WithOptions(opts...)
+	}
+
+	tests := []struct {
+		name string
+		opts []Option
+		cell string
+	}{
+		{"Plain sheet", nil, "B3"},
+		{"Windowed read", []Option{WithOffset(10)}, "B13"},
+		{"Transposed sheet", []Option{WithTransposed()}, "C2"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := newData(tt.opts...).ToStruct()
+			assert.Len(t, result.Errors, 1)
+			assert.Equal(t, 1, result.Errors[0].Column)
+			assert.Equal(t, tt.cell, result.Errors[0].Cell)
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package xlsx_utilities
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// ImportErrorsSheet is the name of the sheet listing the errors given with WithImportErrors
+const ImportErrorsSheet = "Import Errors"
+
+// importErrorHeaders are the columns of the import errors sheet. The row and cell are those of the
+// uploaded sheet, as the exported data may leave out the failed rows.
+var importErrorHeaders = []interface{}{"Upload Row", "Column", "Upload Cell", "Value", "Message"}
+
+// WithImportErrors adds an "Import Errors" sheet to the export listing each error with its row, column,
+// cell, value and message, so the data and the problems found in it go back to the sender in one file.
+// The row and cell locate the error in the uploaded sheet. Texts are written like the other cells of
+// the export, so WithEscapeFormulas covers the uploaded values. No sheet is added when there are no errors.
+func WithImportErrors(errs []ImportError) Option {
+	return func(c *config) {
+		c.importErrors = errs
+	}
+}
+
+// ToWorkbook exports the imported data, with the import errors listed on an "Import Errors" sheet.
+// The failed rows are not part of the data; the errors locate them in the uploaded sheet.
+// The headers of T are written even when no row could be imported.
+func (r *ImportResult[T]) ToWorkbook(opts ...Option) (*excelize.File, error) {
+	var ed *ExcelData[T]
+	if len(r.Data) > 0 {
+		var err error
+		if ed, err = FromStruct(r.Data, opts...); err != nil {
+			return nil, err
+		}
+	} else {
+		headers, err := getStructHeaders(reflect.TypeOf((*T)(nil)).Elem())
+		if err != nil {
+			return nil, fmt.Errorf("error getting headers: %w", err)
+		}
+		ed = NewExcelData[T](headers).WithOptions(opts...)
+	}
+	return ed.ToWorkbook(WithImportErrors(r.Errors))
+}
+
+// applyImportErrors writes the configured import errors to the import errors sheet
+func applyImportErrors(f *excelize.File, cfg *config) error {
+	if len(cfg.importErrors) == 0 {
+		return nil
+	}
+
+	if err := ensureSheet(f, ImportErrorsSheet); err != nil {
+		return err
+	}
+	if err := f.SetSheetRow(ImportErrorsSheet, "A1", &importErrorHeaders); err != nil {
+		return err
+	}
+
+	for i, e := range cfg.importErrors {
+		values := []interface{}{e.RowIndex, e.Header, e.Cell, e.Value, e.message()}
+		for j, value := range values {
+			values[j] = exportText(value, cfg)
+		}
+		if err := f.SetSheetRow(ImportErrorsSheet, fmt.Sprintf("A%d", i+2), &values); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// message returns the description of the error without its position
+func (e ImportError) message() string {
+	if e.Err != nil {
+		return e.Err.Error()
+	}
+	return e.Error()
+}
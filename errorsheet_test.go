@@ -0,0 +1,60 @@
+package xlsx_utilities
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/xuri/excelize/v2"
+)
+
+func TestImportErrorsSheet(t *testing.T) {
+	type shipment struct {
+		Name string
+		Due  time.Time
+	}
+	filename := "test_import_errors.xlsx"
+	defer os.Remove(filename)
+
+	f := excelize.NewFile()
+	assert.NoError(t, f.SetSheetRow("Sheet1", "A1", &[]interface{}{"Name", "Due"}))
+	assert.NoError(t, f.SetSheetRow("Sheet1", "A2", &[]interface{}{"Alice", "2024-05-01"}))
+	assert.NoError(t, f.SetSheetRow("Sheet1", "A3", &[]interface{}{"Bob", "soon"}))
+	assert.NoError(t, f.SaveAs(filename))
+	f.Close()
+
+	excelData, err := FromExcel[shipment](filename)
+	assert.NoError(t, err)
+	result := excelData.ToStruct()
+	assert.Len(t, result.Errors, 1)
+
+	out, err := result.ToWorkbook()
+	assert.NoError(t, err)
+	defer out.Close()
+
+	assert.Equal(t, []string{"Sheet1", ImportErrorsSheet}, out.GetSheetList())
+	rows, err := out.GetRows(ImportErrorsSheet)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Upload Row", "Column", "Upload Cell", "Value", "Message"}, rows[0])
+	assert.Equal(t, []string{"3", "Due", "B3", "soon"}, rows[1][:4])
+	assert.NotEmpty(t, rows[1][4])
+
+	t.Run("Escaped values", func(t *testing.T) {
+		result := &ImportResult[shipment]{Errors: []ImportError{{RowIndex: 2, Header: "Name", Cell: "A2", Value: "=HYPERLINK(\"x\")", Err: ErrTypeMismatch}}}
+		out, err := result.ToWorkbook(WithEscapeFormulas("'"))
+		assert.NoError(t, err)
+		defer out.Close()
+
+		rows, err := out.GetRows(ImportErrorsSheet)
+		assert.NoError(t, err)
+		assert.Equal(t, "'=HYPERLINK(\"x\")", rows[1][3])
+	})
+
+	t.Run("Skipped when reading all sheets", func(t *testing.T) {
+		assert.NoError(t, out.SaveAs(filename))
+		sheets, err := FromExcelAllSheets[shipment](filename)
+		assert.NoError(t, err)
+		assert.Len(t, sheets, 1)
+	})
+}
@@ -0,0 +1,49 @@
+package xlsx_utilities
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// WithHeaderEvolution tolerates files written by older or newer versions of the struct.
+// Fields missing from the file are imported as zero values, and columns without a matching
+// field are ignored; both are reported in ImportResult.HeaderWarnings instead of failing the rows.
+func WithHeaderEvolution() Option {
+	return func(c *config) {
+		c.headerEvolution = true
+	}
+}
+
+// evolveHeaders compares the file headers with the headers of t. It returns the indexes of
+// the file columns to ignore and a warning for every added or removed header.
+func evolveHeaders(t reflect.Type, headers []string) (map[int]bool, []string) {
+	expected, err := getStructHeaders(t)
+	if err != nil {
+		return nil, []string{fmt.Sprintf("cannot compare headers: %v", err)}
+	}
+
+	known := make(map[string]bool, len(expected))
+	for _, header := range expected {
+		known[header] = true
+	}
+
+	present := make(map[string]bool, len(headers))
+	ignored := make(map[int]bool)
+	var warnings []string
+
+	for i, header := range headers {
+		present[header] = true
+		if !known[header] {
+			ignored[i] = true
+			warnings = append(warnings, fmt.Sprintf("column '%s' has no matching field and is ignored", header))
+		}
+	}
+
+	for _, header := range expected {
+		if !present[header] {
+			warnings = append(warnings, fmt.Sprintf("column '%s' is missing and imported as zero value", header))
+		}
+	}
+
+	return ignored, warnings
+}
@@ -0,0 +1,41 @@
+package xlsx_utilities
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/xuri/excelize/v2"
+)
+
+func TestHeaderEvolution(t *testing.T) {
+	type member struct {
+		Name  string
+		Email string
+	}
+
+	filename := "test_evolution.xlsx"
+	defer os.Remove(filename)
+
+	// Written by a version of member without Email and with a since-removed Nickname field
+	f := excelize.NewFile()
+	f.SetCellValue("Sheet1", "A1", "Name")
+	f.SetCellValue("Sheet1", "B1", "Nickname")
+	f.SetCellValue("Sheet1", "A2", "Alice")
+	f.SetCellValue("Sheet1", "B2", "Al")
+	assert.NoError(t, f.SaveAs(filename))
+	f.Close()
+
+	t.Run("Tolerated with warnings", func(t *testing.T) {
+		excelData, err := FromExcel[member](filename, WithHeaderEvolution())
+		assert.NoError(t, err)
+
+		result := excelData.ToStruct()
+		assert.Empty(t, result.Errors)
+		assert.Equal(t, []member{{Name: "Alice"}}, result.Data)
+		assert.Equal(t, []string{
+			"column 'Nickname' has no matching field and is ignored",
+			"column 'Email' is missing and imported as zero value",
+		}, result.HeaderWarnings)
+	})
+}
@@ -3,6 +3,7 @@ package xlsx_utilities
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"reflect"
 
@@ -13,12 +14,20 @@ import (
 type ExcelData[T comparable] struct {
 	Headers []string
 	Rows    [][]interface{}
+	// Provenance holds the provenance of each cell, parallel to Rows; untracked cells are user-provided
+	Provenance [][]Provenance
+
+	options []Option
+	sheet   string // the sheet the rows were read from, empty when they were not read from a workbook
+	lines   []int  // the sheet line of each row read, parallel to Rows; nil when unknown
 }
 
 // ImportError represents an error that occurred during the import process
 type ImportError struct {
 	RowIndex int
 	Header   string
+	Column   int    // index of the header in Headers
+	Cell     string // cell reference of the value, e.g. "C17"; empty for errors of a whole row
 	Value    interface{}
 	Type     reflect.Type
 	Err      error
@@ -28,13 +37,34 @@ type ImportError struct {
 type ImportResult[T comparable] struct {
 	Data   []T
 	Errors []ImportError
+
+	// HeaderWarnings describes the columns tolerated by WithHeaderEvolution: sheet columns without a
+	// field and fields without a column.
+	HeaderWarnings []string
+
+	// CellWarnings lists values that were imported after a recoverable adjustment, such as trimmed
+	// white space, a truncated fraction or a date in a fallback layout. Their rows are kept in Data.
+	CellWarnings []ImportWarning
+
+	// RowNumbers holds the sheet row of each item of Data, numbered as the RowIndex of import errors,
+	// so checks done after the import can still point to the original row. Sheet names the sheet read.
+	RowNumbers []int
+	Sheet      string
 }
 
 // Error returns a string representation of the ImportError
 func (e ImportError) Error() string {
+	if e.Header == "" {
+		return fmt.Sprintf("Row %d: %v", e.RowIndex, e.Err)
+	}
 	return fmt.Sprintf("Row %d, Column '%s': cannot convert '%v' to type %v", e.RowIndex, e.Header, e.Value, e.Type)
 }
 
+// Unwrap returns the underlying error, so errors.Is and errors.As see through an ImportError
+func (e ImportError) Unwrap() error {
+	return e.Err
+}
+
 // NewExcelData creates a new ExcelData instance
 func NewExcelData[T comparable](headers []string) *ExcelData[T] {
 	return &ExcelData[T]{
@@ -46,44 +76,329 @@ func NewExcelData[T comparable](headers []string) *ExcelData[T] {
 // AddRow adds a new row to the ExcelData
 func (ed *ExcelData[T]) AddRow(row []interface{}) error {
 	if len(row) != len(ed.Headers) {
-		return fmt.Errorf("row length (%d) does not match headers length (%d)", len(row), len(ed.Headers))
+		return fmt.Errorf("%w: row length (%d) does not match headers length (%d)", ErrHeaderMismatch, len(row), len(ed.Headers))
 	}
 	ed.Rows = append(ed.Rows, row)
 	return nil
 }
 
+// WithOptions stores options on the ExcelData so they apply to every later read, conversion and export
+func (ed *ExcelData[T]) WithOptions(opts ...Option) *ExcelData[T] {
+	ed.options = append(ed.options, opts...)
+	return ed
+}
+
+// config resolves the stored options followed by the given ones
+func (ed *ExcelData[T]) config(opts ...Option) *config {
+	all := make([]Option, 0, len(ed.options)+len(opts))
+	all = append(all, ed.options...)
+	all = append(all, opts...)
+	return newConfig(all)
+}
+
 // ToExcel generates an Excel file from the ExcelData
-func (ed *ExcelData[T]) ToExcel(filename string) error {
-	return ed.Save(filename)
+func (ed *ExcelData[T]) ToExcel(filename string, opts ...Option) error {
+	return ed.Save(filename, opts...)
 }
 
-// Save the Excel file
-func (ed *ExcelData[T]) Save(filename string) error {
-	f := ed.ToFile()
+// Save the Excel file. It is written to a temporary file next to filename first and renamed over it,
+// so a crash during the save leaves the previous file intact; WithFsync also flushes it to disk.
+func (ed *ExcelData[T]) Save(filename string, opts ...Option) error {
+	cfg := ed.config(opts...)
+
+	f, err := ed.ToWorkbook(opts...)
+	if err != nil {
+		return err
+	}
 	defer f.Close()
 
-	return f.SaveAs(filename)
+	return saveWorkbook(f, filename, cfg)
 }
 
-// ToFile generates an Excel file from the ExcelData
+// ToFile generates an Excel file from the ExcelData.
+// It returns nil when the configured options cannot be applied; use ToWorkbook to get the error.
 func (ed *ExcelData[T]) ToFile() *excelize.File {
-	f := excelize.NewFile()
+	f, _ := ed.ToWorkbook()
+	return f
+}
+
+// ToWorkbook generates an Excel file from the ExcelData, applying the stored and given options
+func (ed *ExcelData[T]) ToWorkbook(opts ...Option) (*excelize.File, error) {
+	cfg := ed.config(opts...)
+
+	f, err := openWorkbook(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ed.writeWorkbook(f, cfg); err != nil {
+		f.Close()
+		return nil, err
+	}
+
+	return f, nil
+}
+
+// AddToWorkbook writes the ExcelData to a sheet of an existing workbook, creating the sheet when missing.
+// This allows mixing several exports, e.g. user-facing tabs with hidden data tabs, in one file.
+func (ed *ExcelData[T]) AddToWorkbook(f *excelize.File, opts ...Option) error {
+	cfg := ed.config(opts...)
+
+	if err := ensureSheet(f, cfg.sheet); err != nil {
+		return err
+	}
+
+	return ed.writeWorkbook(f, cfg)
+}
+
+// writeWorkbook writes the data to the configured sheet and applies the export features.
+// Data wider than the sheet is handled according to the configured WidePolicy.
+func (ed *ExcelData[T]) writeWorkbook(f *excelize.File, cfg *config) error {
+	ed, err := ed.beforeWrite(cfg)
+	if err != nil {
+		return err
+	}
+	ed = ed.forViewer(cfg)
+
+	if cfg.transposed {
+		if err := checkTransposed(cfg, len(ed.Headers), len(ed.Rows)); err != nil {
+			return err
+		}
+		layout, err := ed.writeSheet(f, cfg)
+		if err != nil {
+			return err
+		}
+		if err := ed.decorateSheet(f, layout, cfg); err != nil {
+			return err
+		}
+		if err := applyImportErrors(f, cfg); err != nil {
+			return fmt.Errorf("error writing import errors: %w", err)
+		}
+		return nil
+	}
+
+	col, row, err := excelize.CellNameToCoordinates(cfg.anchor)
+	if err != nil {
+		return fmt.Errorf("invalid anchor cell '%s': %w", cfg.anchor, err)
+	}
+
+	parts, err := ed.fitColumns(cfg, excelize.MaxColumns-col+1)
+	if err != nil {
+		return err
+	}
+
+	sheets := 0 // the additional sheets written so far
+	for n, part := range parts {
+		partCfg := cfg
+		if n > 0 {
+			sheets++
+			if partCfg, err = continuationConfig(f, cfg, continuationSheet(cfg.sheet, sheets)); err != nil {
+				return err
+			}
+		}
+
+		headerRows := cfg.headerRows()
+		if cfg.skipHeaders {
+			headerRows = 0
+		}
+		chunks, err := part.fitRows(partCfg, sheetRows(row, headerRows), sheetRows(1, cfg.headerRows()))
+		if err != nil {
+			return err
+		}
+
+		for m, chunk := range chunks {
+			chunkCfg := partCfg
+			if m > 0 {
+				// continuation sheets start at the top with their own header row
+				sheets++
+				if chunkCfg, err = continuationConfig(f, partCfg, continuationSheet(cfg.sheet, sheets)); err != nil {
+					return err
+				}
+				chunkCfg.anchor, _ = excelize.CoordinatesToCellName(col, 1)
+				chunkCfg.skipHeaders = false
+			}
+
+			layout, err := chunk.writeSheet(f, chunkCfg)
+			if err != nil {
+				return err
+			}
+
+			if err := chunk.decorateSheet(f, layout, chunkCfg); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := applyImportErrors(f, cfg); err != nil {
+		return fmt.Errorf("error writing import errors: %w", err)
+	}
+	return nil
+}
+
+// decorateSheet applies the optional export features on top of the written data
+func (ed *ExcelData[T]) decorateSheet(f *excelize.File, layout sheetLayout, cfg *config) error {
+	itemStyles, err := ed.itemStyles(cfg)
+	if err != nil {
+		return fmt.Errorf("error applying styles: %w", err)
+	}
+
+	if err := applyStyles(f, layout, cfg, ed.Headers, ed.Rows, itemStyles); err != nil {
+		return fmt.Errorf("error applying styles: %w", err)
+	}
+
+	if err := applyColumnWidths(f, layout, cfg, ed.Headers); err != nil {
+		return fmt.Errorf("error setting column widths: %w", err)
+	}
+
+	if err := applyWrapText(f, layout, cfg, ed.Rows); err != nil {
+		return fmt.Errorf("error wrapping text: %w", err)
+	}
+
+	if err := applyLocaleFormats(f, layout, cfg, ed.Rows); err != nil {
+		return fmt.Errorf("error applying locale formats: %w", err)
+	}
+
+	if err := applyTextFormats(f, layout, reflect.TypeOf((*T)(nil)).Elem(), ed.Headers); err != nil {
+		return fmt.Errorf("error applying text formats: %w", err)
+	}
+
+	if err := applyMerges(f, layout, cfg, ed.Headers); err != nil {
+		return fmt.Errorf("error merging cells: %w", err)
+	}
+
+	if err := applyOutlines(f, layout, cfg, ed.Headers); err != nil {
+		return fmt.Errorf("error grouping outline: %w", err)
+	}
+
+	if err := applyReferenceSheet(f, layout, cfg, reflect.TypeOf((*T)(nil)).Elem(), ed.Headers, ed.Rows); err != nil {
+		return fmt.Errorf("error writing reference sheet: %w", err)
+	}
+
+	if err := applyTemplateHints(f, layout, cfg, reflect.TypeOf((*T)(nil)).Elem(), ed.Headers); err != nil {
+		return fmt.Errorf("error adding template hints: %w", err)
+	}
+
+	if err := applyNamedRanges(f, layout, cfg, ed.Headers); err != nil {
+		return fmt.Errorf("error defining named range: %w", err)
+	}
+
+	if err := applyTable(f, layout, cfg); err != nil {
+		return fmt.Errorf("error adding table: %w", err)
+	}
+
+	if err := applyPivotTables(f, layout, cfg, ed.Headers); err != nil {
+		return fmt.Errorf("error adding pivot table: %w", err)
+	}
+
+	if err := applyCharts(f, layout, cfg, ed.Headers); err != nil {
+		return fmt.Errorf("error adding chart: %w", err)
+	}
+
+	if err := applySheetDirection(f, layout, cfg); err != nil {
+		return fmt.Errorf("error setting sheet direction: %w", err)
+	}
+
+	if err := applySchema(f, layout, cfg, ed.Headers, ed.Rows); err != nil {
+		return fmt.Errorf("error writing schema: %w", err)
+	}
+
+	if err := ed.applyProvenance(f, layout, cfg); err != nil {
+		return fmt.Errorf("error writing provenance: %w", err)
+	}
+
+	if err := applySheetProtection(f, layout, cfg); err != nil {
+		return fmt.Errorf("error protecting sheet: %w", err)
+	}
+
+	if err := applySheetVisibility(f, layout, cfg); err != nil {
+		return fmt.Errorf("error setting sheet visibility: %w", err)
+	}
+
+	return nil
+}
+
+// ensureSheet creates the sheet when the workbook does not have it
+func ensureSheet(f *excelize.File, sheet string) error {
+	index, err := f.GetSheetIndex(sheet)
+	if err != nil {
+		return err
+	}
+	if index == -1 {
+		_, err = f.NewSheet(sheet)
+	}
+	return err
+}
+
+// openWorkbook opens the configured template, or creates a new file, and makes sure the target sheet exists
+func openWorkbook(cfg *config) (*excelize.File, error) {
+	if cfg.template == "" {
+		f := excelize.NewFile()
+		if cfg.sheet != defaultSheet {
+			if err := f.SetSheetName(defaultSheet, cfg.sheet); err != nil {
+				f.Close()
+				return nil, err
+			}
+		}
+		return f, nil
+	}
+
+	f, err := excelize.OpenFile(cfg.template)
+	if err != nil {
+		return nil, fmt.Errorf("error opening template: %w", err)
+	}
+
+	if index, err := f.GetSheetIndex(cfg.sheet); err != nil || index == -1 {
+		f.Close()
+		return nil, fmt.Errorf("sheet '%s' not found in template", cfg.sheet)
+	}
+
+	return f, nil
+}
+
+// writeSheet writes headers and rows starting at the configured anchor cell.
+// Only cell values are set, so any styling already present on the sheet is kept.
+func (ed *ExcelData[T]) writeSheet(f *excelize.File, cfg *config) (sheetLayout, error) {
+	layout, err := exportLayout(cfg, len(ed.Headers), len(ed.Rows))
+	if err != nil {
+		return layout, err
+	}
 
 	// Write headers
-	for col, header := range ed.Headers {
-		cell := fmt.Sprintf("%s1", intToExcelColumn(col))
-		f.SetCellValue("Sheet1", cell, header)
+	if layout.HeaderRow {
+		t := reflect.TypeOf((*T)(nil)).Elem()
+		labels := headerLabels(t, ed.Headers, cfg)
+		if cfg.groupedHeaders {
+			if layout, labels, err = writeGroupRow(f, layout, t, ed.Headers, labels, cfg); err != nil {
+				return layout, err
+			}
+		}
+		for i, header := range labels {
+			if err := f.SetCellValue(layout.Sheet, layout.cell(i, layout.Row), header); err != nil {
+				return layout, err
+			}
+		}
 	}
 
 	// Write data
-	for rowIndex, row := range ed.Rows {
-		for col, value := range row {
-			cell := fmt.Sprintf("%s%d", intToExcelColumn(col), rowIndex+2)
-			f.SetCellValue("Sheet1", cell, value)
+	text := textColumns(reflect.TypeOf((*T)(nil)).Elem(), ed.Headers)
+	for rowIndex, values := range ed.Rows {
+		for i, value := range values {
+			value, err := cfg.formatColumn(ed.Headers[i], value)
+			if err != nil {
+				return layout, fmt.Errorf("error formatting column '%s': %w", ed.Headers[i], err)
+			}
+			if text[i] {
+				value = textValue(value)
+			}
+			value = exportText(value, cfg)
+			value = localizeValue(value, cfg)
+			if err := f.SetCellValue(layout.Sheet, layout.cell(i, layout.firstDataRow()+rowIndex), value); err != nil {
+				return layout, err
+			}
 		}
 	}
 
-	return f
+	return layout, nil
 }
 
 // intToExcelColumn converts a 0-based column index to an Excel column name (A, B, C, ..., Z, AA, AB, etc.)
@@ -106,58 +421,121 @@ func excelColumnToInt(columnName string) int {
 }
 
 // FromExcel reads an Excel file into ExcelData
-func FromFileExcel[T comparable](file *bytes.Reader) (*ExcelData[T], error) {
-	f, err := excelize.OpenReader(file)
+func FromFileExcel[T comparable](file *bytes.Reader, opts ...Option) (*ExcelData[T], error) {
+	f, err := openExcelReader(file, newConfig(opts))
 	if err != nil {
 		return nil, err
 	}
 	defer f.Close()
 
-	rows, err := f.GetRows("Sheet1")
+	return fromWorkbook[T](f, opts)
+}
+
+// FromExcel reads an Excel file into ExcelData
+func FromExcel[T comparable](filename string, opts ...Option) (*ExcelData[T], error) {
+	f, err := openExcelFile(filename, newConfig(opts))
 	if err != nil {
 		return nil, err
 	}
+	defer f.Close()
+
+	return fromWorkbook[T](f, opts)
+}
 
-	if len(rows) < 2 {
-		return nil, fmt.Errorf("excel file is empty or has no data rows")
+// fromWorkbook reads the configured sheet of an opened workbook into ExcelData
+func fromWorkbook[T comparable](f *excelize.File, opts []Option) (*ExcelData[T], error) {
+	cfg, err := selectSheet(f, newConfig(opts))
+	if err != nil {
+		return nil, err
 	}
 
-	ed := NewExcelData[T](rows[0])
+	if err := cfg.limits.checkSheet(f, cfg); err != nil {
+		return nil, err
+	}
 
-	for _, row := range rows[1:] {
-		interfaceRow := make([]interface{}, len(row))
-		for i, cell := range row {
-			interfaceRow[i] = convertCellValue(cell)
-		}
-		ed.Rows = append(ed.Rows, interfaceRow)
+	if err := sanitize(f, cfg); err != nil {
+		return nil, fmt.Errorf("error sanitizing workbook: %w", err)
 	}
 
-	return ed, nil
-}
+	schema, err := readSchema(f, cfg.sheet)
+	if err != nil {
+		return nil, fmt.Errorf("error reading schema: %w", err)
+	}
+	if schema != nil {
+		// typed columns are parsed from the stored values rather than their formatted text
+		raw := *cfg
+		raw.rawValues = true
+		cfg = &raw
+	}
 
-// FromExcel reads an Excel file into ExcelData
-func FromExcel[T comparable](filename string) (*ExcelData[T], error) {
-	f, err := excelize.OpenFile(filename)
+	rows, lines, native, err := readRows(f, cfg)
 	if err != nil {
 		return nil, err
 	}
-	defer f.Close()
 
-	rows, err := f.GetRows("Sheet1")
+	ed, err := fromRows[T](rows, lines, schema, native, opts)
 	if err != nil {
 		return nil, err
 	}
+	ed.sheet = cfg.sheet
 
-	if len(rows) < 2 {
-		return nil, fmt.Errorf("excel file is empty or has no data rows")
+	if cfg.provenance {
+		if err := ed.readProvenance(f, cfg); err != nil {
+			return nil, fmt.Errorf("error reading provenance: %w", err)
+		}
 	}
 
-	ed := NewExcelData[T](rows[0])
+	return ed, nil
+}
+
+// fromRows converts the cell text of a sheet, headers first, into ExcelData.
+// Columns described by the schema are parsed to their Go type and text columns are kept as they
+// are; the others take their native type when read with WithNativeTypes, or are inferred, or kept
+// as text with WithRawStrings.
+// lines holds the sheet line of each row, or is nil when unknown.
+func fromRows[T comparable](rows [][]string, lines []int, schema []schemaColumn, native nativeRows, opts []Option) (*ExcelData[T], error) {
+	cfg := newConfig(opts)
+
+	// a window past the last row is an empty page rather than an empty file
+	if len(rows) == 0 || len(rows) == 1 && cfg.offset == 0 {
+		return nil, ErrEmptyFile
+	}
+
+	headers := rows[0]
+	if schema != nil && len(schema) != len(headers) {
+		return nil, fmt.Errorf("%w: schema describes %d columns, the sheet has %d", ErrHeaderMismatch, len(schema), len(headers))
+	}
+	for i := range schema {
+		headers[i] = schema[i].Header
+	}
+
+	ed := NewExcelData[T](headers)
+	ed.options = opts
+	if len(lines) == len(rows) {
+		ed.lines = lines[1:]
+	}
+
+	var text map[int]bool
+	if t := reflect.TypeOf((*T)(nil)).Elem(); t.Kind() == reflect.Struct {
+		text = textColumns(t, canonicalHeaders(t, headers, cfg))
+	}
 
-	for _, row := range rows[1:] {
+	for r, row := range rows[1:] {
 		interfaceRow := make([]interface{}, len(row))
+		var typed nativeRow
+		if ed.lines != nil {
+			typed = native.row(ed.lines[r])
+		}
 		for i, cell := range row {
-			interfaceRow[i] = convertCellValue(cell)
+			if i < len(schema) {
+				interfaceRow[i] = parseSchemaValue(schema[i].Type, cell)
+			} else if text[i] {
+				interfaceRow[i] = cell
+			} else if value, ok := typed.value(i, cell); ok {
+				interfaceRow[i] = value
+			} else {
+				interfaceRow[i] = cfg.parseCell(cell)
+			}
 		}
 		ed.Rows = append(ed.Rows, interfaceRow)
 	}
@@ -165,80 +543,318 @@ func FromExcel[T comparable](filename string) (*ExcelData[T], error) {
 	return ed, nil
 }
 
+// WithKeepPartialRows keeps rows with cells that cannot be converted instead of discarding them.
+// The failing fields are left at their zero value and the errors are still reported; rows rejected
+// by a WithAfterReadRow hook are discarded as usual.
+func WithKeepPartialRows() Option {
+	return func(c *config) {
+		c.keepPartialRows = true
+	}
+}
+
+// WithReleaseRows makes ToStruct drop each row once it is converted and leave Rows empty afterwards,
+// so the raw cell values of a large import can be garbage collected while the records are built
+func WithReleaseRows() Option {
+	return func(c *config) {
+		c.releaseRows = true
+	}
+}
+
 // ToStruct converts ExcelData to a slice of struct T and collects import errors
 func (ed *ExcelData[T]) ToStruct() ImportResult[T] {
-	var result []T
-	var importErrors []ImportError
+	cfg := ed.config()
+	conv := ed.newRowConverter(cfg)
+
+	result := ImportResult[T]{
+		Data:           make([]T, 0, len(ed.Rows)),
+		HeaderWarnings: conv.headerWarnings,
+		RowNumbers:     make([]int, 0, len(ed.Rows)),
+		Sheet:          ed.sheet,
+	}
+	if cfg.parallelism > 1 {
+		for _, row := range conv.convertParallel(ed.Rows, cfg.parallelism) {
+			result.add(row)
+		}
+	} else {
+		conv.convertRows(ed.Rows, func(row convertedRow[T]) bool {
+			result.add(row)
+			if cfg.releaseRows {
+				ed.Rows[row.index] = nil
+			}
+			return true
+		})
+	}
+	if cfg.releaseRows {
+		ed.Rows = nil
+	}
 
-	t := reflect.TypeOf((*T)(nil)).Elem()
+	return result
+}
 
-	for rowIndex, row := range ed.Rows {
-		item := reflect.New(t).Elem()
-		rowErrors := []ImportError{}
-
-		for i, header := range ed.Headers {
-			if i < len(row) {
-				err := setNestedField(item, header, row[i])
-				if err != nil {
-					fieldType := item.FieldByName(header).Type()
-					if fieldType.Kind() == reflect.Ptr {
-						fieldType = fieldType.Elem()
-					}
-
-					rowErrors = append(rowErrors, ImportError{
-						RowIndex: rowIndex + 2, // +2 because Excel rows are 1-indexed and we skip the header
-						Header:   header,
-						Value:    row[i],
-						Type:     fieldType,
-						Err:      err,
-					})
-				}
+// add appends the record of a kept row with its sheet row, and the errors and warnings of the row
+func (r *ImportResult[T]) add(row convertedRow[T]) {
+	if row.kept {
+		r.Data = append(r.Data, row.item)
+		r.RowNumbers = append(r.RowNumbers, row.line)
+	}
+	r.Errors = append(r.Errors, row.errors...)
+	r.CellWarnings = append(r.CellWarnings, row.warnings...)
+}
+
+// rowConverter converts rows to T, with the field of each header resolved once up front
+type rowConverter[T comparable] struct {
+	t              reflect.Type
+	headers        []string
+	fields         []string // the headers with translated ones replaced by the header of their field
+	plans          []*fieldPlan
+	styles         []*NumberStyle
+	bools          []bool
+	lookups        []map[string]interface{}
+	parsers        []CustomTypeParser
+	ignored        map[int]bool
+	headerWarnings []string
+	lines          []int
+	cfg            *config
+}
+
+// newRowConverter prepares the conversion of the rows of ed
+func (ed *ExcelData[T]) newRowConverter(cfg *config) *rowConverter[T] {
+	conv := &rowConverter[T]{
+		t:       reflect.TypeOf((*T)(nil)).Elem(),
+		headers: ed.Headers,
+		lines:   ed.lines,
+		cfg:     cfg,
+	}
+	conv.fields = canonicalHeaders(conv.t, ed.Headers, cfg)
+
+	if cfg.headerEvolution {
+		conv.ignored, conv.headerWarnings = evolveHeaders(conv.t, conv.fields)
+	}
+	if cfg.referenceSheet != "" {
+		for i := range labelColumns(conv.t, conv.fields) {
+			if conv.ignored == nil {
+				conv.ignored = make(map[int]bool)
 			}
+			conv.ignored[i] = true
+		}
+	}
+
+	conv.plans = make([]*fieldPlan, len(ed.Headers))
+	conv.styles = make([]*NumberStyle, len(ed.Headers))
+	conv.bools = make([]bool, len(ed.Headers))
+	conv.lookups = make([]map[string]interface{}, len(ed.Headers))
+	conv.parsers = make([]CustomTypeParser, len(ed.Headers))
+	for i, header := range conv.fields {
+		conv.lookups[i] = cfg.lookupFor(ed.Headers[i], header)
+		conv.parsers[i] = cfg.columnParserFor(ed.Headers[i], header)
+		conv.plans[i] = planField(conv.t, header)
+		conv.styles[i] = cfg.numberStyles.styleFor(header, conv.plans[i].target())
+		conv.bools[i] = isBoolType(conv.plans[i].target())
+	}
+	return conv
+}
+
+// sheetRow returns the 1-based row of data row rowIndex in the sheet read
+func (conv *rowConverter[T]) sheetRow(rowIndex int) int {
+	return sheetLine(conv.lines, rowIndex, conv.cfg)
+}
+
+// sheetLine returns the 1-based sheet line of data row rowIndex: the line it was read from,
+// or for rows of unknown origin its position below the header rows
+func sheetLine(lines []int, rowIndex int, cfg *config) int {
+	if rowIndex < len(lines) {
+		return lines[rowIndex]
+	}
+	return rowIndex + 1 + cfg.headerRows() + cfg.offset
+}
+
+// cell returns the reference of the cell holding column col of data row rowIndex in the sheet read
+func (conv *rowConverter[T]) cell(rowIndex, col int) string {
+	row, column := conv.sheetRow(rowIndex), col+1
+	if conv.cfg.transposed {
+		row, column = column, row
+	}
+	name, _ := excelize.CoordinatesToCellName(column, row)
+	return name
+}
+
+// convertedRow is the outcome of converting one row: the record, whether keep accepts it, and
+// the errors and warnings of its cells
+type convertedRow[T comparable] struct {
+	index    int // index of the row in the dataset
+	line     int // sheet row, numbered as the RowIndex of import errors
+	item     T
+	kept     bool
+	errors   []ImportError
+	warnings []ImportWarning
+}
+
+// convertRow converts the row at rowIndex
+func (conv *rowConverter[T]) convertRow(rowIndex int, row []interface{}) convertedRow[T] {
+	item, rowErrors, rowWarnings := conv.convert(rowIndex, row)
+	return convertedRow[T]{
+		index:    rowIndex,
+		line:     conv.sheetRow(rowIndex),
+		item:     item,
+		kept:     conv.keep(rowErrors),
+		errors:   rowErrors,
+		warnings: rowWarnings,
+	}
+}
+
+// convertRows converts the rows in order and passes each outcome to fn, stopping when fn returns false.
+// ToStruct, ToStructStream, ToStructBatchResults and Validate are built on it.
+func (conv *rowConverter[T]) convertRows(rows [][]interface{}, fn func(row convertedRow[T]) bool) {
+	for rowIndex, row := range rows {
+		if !fn(conv.convertRow(rowIndex, row)) {
+			return
+		}
+	}
+}
+
+// keep reports whether a row converted with the given errors is added to the data: rows without errors,
+// and with WithKeepPartialRows also rows whose only errors are cell values left at their zero value
+func (conv *rowConverter[T]) keep(rowErrors []ImportError) bool {
+	if !conv.cfg.keepPartialRows {
+		return len(rowErrors) == 0
+	}
+	for _, err := range rowErrors {
+		if err.Header == "" {
+			return false
+		}
+	}
+	return true
+}
+
+// convert converts a single row, reporting values adjusted on the way as warnings.
+// The item is only meaningful when keep accepts the errors.
+func (conv *rowConverter[T]) convert(rowIndex int, row []interface{}) (T, []ImportError, []ImportWarning) {
+	item := reflect.New(conv.t).Elem()
+	rowErrors := []ImportError{}
+	var warnings []ImportWarning
+	setter, _ := item.Addr().Interface().(FieldSetter)
+
+	for i, header := range conv.headers {
+		if conv.ignored[i] {
+			continue
+		}
+		if i >= len(row) {
+			continue
 		}
+		cell := row[i]
+		if conv.lookups[i] != nil {
+			cell = lookupValue(conv.lookups[i], cell)
+		}
+		if !isNullValue(cell, conv.cfg.nullValues) {
+			value := applyNumberStyle(conv.styles[i], cell)
+			// a text the number style parsed is a number written in the style of the column
+			text, isText := cell.(string)
+			styled := isText && conv.styles[i] != nil && value != text
+			if conv.bools[i] {
+				value = boolSynonym(value, conv.cfg.boolSynonyms)
+			}
+
+			var err error
+			set := true
+			if conv.parsers[i] != nil {
+				value, set, err = parseColumn(conv.parsers[i], cell, conv.plans[i].target())
+			} else if conv.cfg.strictTypes {
+				err = checkStrictType(conv.plans[i], value, styled || conv.cfg.rawStrings)
+			}
+			if err == nil && set && setter != nil {
+				err = setter.SetXLSXField(conv.fields[i], value)
+			} else if err == nil && set {
+				err = conv.plans[i].set(item, value)
+			}
 
-		if len(rowErrors) == 0 {
-			result = append(result, item.Interface().(T))
+			var adj *adjusted
+			if errors.As(err, &adj) {
+				warnings = append(warnings, ImportWarning{
+					RowIndex: conv.sheetRow(rowIndex),
+					Header:   header,
+					Column:   i,
+					Cell:     conv.cell(rowIndex, i),
+					Value:    row[i],
+					Message:  adj.message,
+				})
+			} else if err != nil {
+				fieldType := headerFieldType(conv.t, conv.fields[i])
+
+				rowErrors = append(rowErrors, ImportError{
+					RowIndex: conv.sheetRow(rowIndex),
+					Header:   header,
+					Column:   i,
+					Cell:     conv.cell(rowIndex, i),
+					Value:    row[i],
+					Type:     fieldType,
+					Err:      err,
+				})
+			}
 		}
-		importErrors = append(importErrors, rowErrors...)
 	}
 
-	return ImportResult[T]{
-		Data:   result,
-		Errors: importErrors,
+	if conv.keep(rowErrors) {
+		for _, hook := range conv.cfg.afterRead {
+			if err := hook(rowIndex, item.Addr().Interface()); err != nil {
+				rowErrors = append(rowErrors, ImportError{RowIndex: conv.sheetRow(rowIndex), Err: err})
+				break
+			}
+		}
 	}
+
+	return item.Interface().(T), rowErrors, warnings
 }
 
 // FromStruct converts a slice of struct T to ExcelData, supporting nested structs
-func FromStruct[T comparable](data []T) (*ExcelData[T], error) {
+func FromStruct[T comparable](data []T, opts ...Option) (*ExcelData[T], error) {
 	if len(data) == 0 {
-		return nil, fmt.Errorf("input slice is empty")
+		return nil, ErrEmptyInput
+	}
+
+	cfg := newConfig(opts)
+	if cfg.strictRoundTrip {
+		var issues roundTripIssues
+		for _, item := range data {
+			checkRoundTrip(reflect.ValueOf(item), "", &issues)
+		}
+		if err := issues.err(); err != nil {
+			return nil, err
+		}
 	}
 
 	t := reflect.TypeOf((*T)(nil)).Elem()
 	headers, err := getStructHeaders(t)
 	if err != nil {
-		return nil, fmt.Errorf("error getting headers: %v", err)
+		return nil, fmt.Errorf("error getting headers: %w", err)
 	}
 
 	ed := NewExcelData[T](headers)
+	ed.options = opts
 
 	for i, item := range data {
-		row, err := getStructValues(reflect.ValueOf(item))
-		if err != nil {
-			return nil, fmt.Errorf("error getting values for item %d: %v", i, err)
+		if err := ed.addStruct(i, item); err != nil {
+			return nil, err
 		}
+	}
 
-		if len(row) != len(headers) {
-			return nil, fmt.Errorf("mismatch between headers (%d) and values (%d) for item %d", len(headers), len(row), i)
-		}
+	return ed, nil
+}
 
-		err = ed.AddRow(row)
-		if err != nil {
-			return nil, fmt.Errorf("error adding row %d: %v", i, err)
-		}
+// addStruct adds the flattened values of the i-th item as a row
+func (ed *ExcelData[T]) addStruct(i int, item T) error {
+	row, err := getStructValues(reflect.ValueOf(item))
+	if err != nil {
+		return fmt.Errorf("error getting values for item %d: %w", i, err)
 	}
 
-	return ed, nil
+	if len(row) != len(ed.Headers) {
+		return fmt.Errorf("mismatch between headers (%d) and values (%d) for item %d", len(ed.Headers), len(row), i)
+	}
+
+	if err := ed.AddRow(row); err != nil {
+		return fmt.Errorf("error adding row %d: %w", i, err)
+	}
+	return nil
 }
 
 // FormatImportErrors returns a formatted string of all import errors
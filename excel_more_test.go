@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/xuri/excelize/v2"
 )
 
 type Address struct {
@@ -183,3 +184,70 @@ func TestComplexPointerStruct(t *testing.T) {
 		}
 	})
 }
+
+func TestImportRowNumbers(t *testing.T) {
+	type order struct {
+		Customer string
+		Due      time.Time
+	}
+	filename := "test_row_numbers.xlsx"
+	defer os.Remove(filename)
+
+	f := excelize.NewFile()
+	_, err := f.NewSheet("Orders")
+	assert.NoError(t, err)
+	assert.NoError(t, f.SetSheetRow("Orders", "A1", &[]interface{}{"Customer", "Due"}))
+	assert.NoError(t, f.SetSheetRow("Orders", "A2", &[]interface{}{"Alice", "2024-05-01"}))
+	assert.NoError(t, f.SetSheetRow("Orders", "A3", &[]interface{}{"Bob", "soon"}))
+	assert.NoError(t, f.SetSheetRow("Orders", "A4", &[]interface{}{"Carol", "2024-05-03"}))
+	assert.NoError(t, f.SaveAs(filename))
+	f.Close()
+
+	excelData, err := FromExcel[order](filename, WithSheetIndex(1))
+	assert.NoError(t, err)
+	result := excelData.ToStruct()
+	assert.Len(t, result.Data, 2)
+	assert.Equal(t, []int{2, 4}, result.RowNumbers)
+	assert.Equal(t, "Orders", result.Sheet)
+	assert.Equal(t, 3, result.Errors[0].RowIndex)
+
+	t.Run("Parallel", func(t *testing.T) {
+		excelData, err := FromExcel[order](filename, WithSheet("Orders"), WithParallelism(2))
+		assert.NoError(t, err)
+		assert.Equal(t, []int{2, 4}, excelData.ToStruct().RowNumbers)
+	})
+
+	t.Run("Offset", func(t *testing.T) {
+		excelData, err := FromExcel[order](filename, WithSheet("Orders"), WithOffset(2))
+		assert.NoError(t, err)
+		assert.Equal(t, []int{4}, excelData.ToStruct().RowNumbers)
+	})
+
+	t.Run("Skipped rows", func(t *testing.T) {
+		skipped := "test_row_numbers_skipped.xlsx"
+		defer os.Remove(skipped)
+
+		f := excelize.NewFile()
+		assert.NoError(t, f.SetSheetRow("Sheet1", "A1", &[]interface{}{"Customer", "Due"}))
+		assert.NoError(t, f.SetSheetRow("Sheet1", "A2", &[]interface{}{"Alice", "2024-05-01"}))
+		assert.NoError(t, f.SetSheetRow("Sheet1", "A4", &[]interface{}{"Bob", "2024-05-02"}))
+		assert.NoError(t, f.SetSheetRow("Sheet1", "A5", &[]interface{}{"Carol", "2024-05-03"}))
+		assert.NoError(t, f.SaveAs(skipped))
+		f.Close()
+
+		excelData, err := FromExcel[order](skipped, WithSkipBlankRows())
+		assert.NoError(t, err)
+		assert.Equal(t, []int{2, 4, 5}, excelData.ToStruct().RowNumbers)
+
+		notBob := excelData.Filter(func(row Row) bool { return row.Text("Customer") != "Bob" })
+		assert.Equal(t, []int{2, 5}, notBob.ToStruct().RowNumbers)
+
+		reversed := excelData.Sort(func(a, b Row) bool { return a.Text("Customer") > b.Text("Customer") })
+		assert.Equal(t, []int{5, 4, 2}, reversed.ToStruct().RowNumbers)
+		assert.Equal(t, []int{4}, reversed.Slice(1, 1).ToStruct().RowNumbers)
+
+		piped, err := excelData.Pipe(Filter(func(row Row) bool { return row.Text("Customer") != "Alice" }), Limit(1))
+		assert.NoError(t, err)
+		assert.Equal(t, []int{4}, piped.ToStruct().RowNumbers)
+	})
+}
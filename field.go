@@ -3,66 +3,12 @@ package xlsx_utilities
 import (
 	"fmt"
 	"reflect"
-	"strconv"
-	"strings"
 	"time"
 )
 
+// setNestedField sets the field named by the header path, e.g. "Address City", on the struct value v
 func setNestedField(v reflect.Value, fieldPath string, value interface{}) error {
-	fields := strings.Split(fieldPath, " ")
-	for i, field := range fields {
-		if v.Kind() == reflect.Ptr {
-			if v.IsNil() {
-				v.Set(reflect.New(v.Type().Elem()))
-			}
-			v = v.Elem()
-		}
-
-		if v.Kind() != reflect.Struct {
-			return fmt.Errorf("not a struct: %v", v.Kind())
-		}
-
-		f := v.FieldByName(field)
-		if !f.IsValid() {
-			return fmt.Errorf("no such field: %s in obj", field)
-		}
-
-		if i == len(fields)-1 {
-			// Check if there's a custom type converter
-			if converter, ok := TypeParsers[f.Type()]; ok {
-				convertedValue, err := converter(fmt.Sprintf("%v", value))
-				if err != nil {
-					return fmt.Errorf("error parsing custom type: %v", err)
-				}
-
-				if convertedValue == nil {
-					return nil
-				}
-
-				f.Set(reflect.ValueOf(convertedValue))
-				return nil
-			}
-
-			return setField(f, value)
-		}
-
-		if f.Kind() == reflect.Ptr {
-			if f.IsNil() {
-				f.Set(reflect.New(f.Type().Elem()))
-			}
-			v = f.Elem()
-		} else if f.Kind() == reflect.Slice {
-			if f.IsNil() {
-				f.Set(reflect.MakeSlice(f.Type(), 0, 0))
-			}
-			newElem := reflect.New(f.Type().Elem()).Elem()
-			f.Set(reflect.Append(f, newElem))
-			v = f.Index(f.Len() - 1)
-		} else {
-			v = f
-		}
-	}
-	return nil
+	return planField(v.Type(), fieldPath).set(v, value)
 }
 
 // setField sets the value of a struct field, handling type conversions
@@ -71,19 +17,28 @@ func setField(field reflect.Value, value interface{}) error {
 		return fmt.Errorf("cannot set field")
 	}
 
-	// Handle pointer types
+	// Handle pointer types; an empty cell leaves a nil pointer nil
 	if field.Kind() == reflect.Ptr {
 		if field.IsNil() {
+			if isEmptyCell(value) {
+				return nil
+			}
 			field.Set(reflect.New(field.Type().Elem()))
 		}
 		return setField(field.Elem(), value)
 	}
 
+	// values of the field type, such as date cells read as times, are set as they are
+	if value != nil && reflect.TypeOf(value) == field.Type() {
+		field.Set(reflect.ValueOf(value))
+		return nil
+	}
+
 	// Check if there's a custom type converter
-	if converter, ok := TypeParsers[field.Type()]; ok {
+	if converter, ok := parserFor(field.Type()); ok {
 		convertedValue, err := converter(fmt.Sprintf("%v", value))
 		if err != nil {
-			return fmt.Errorf("error parsing custom type: %v", err)
+			return &ConversionError{Value: value, Type: field.Type(), Err: err}
 		}
 
 		if convertedValue == nil {
@@ -104,39 +59,43 @@ func setField(field reflect.Value, value interface{}) error {
 			field.SetString(fmt.Sprintf("%v", value))
 		}
 	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
-		intVal, err := strconv.ParseInt(fmt.Sprintf("%v", value), 10, 64)
+		intVal, message, err := parseIntLenient(fmt.Sprintf("%v", value))
 		if err != nil {
 			field.Set(reflect.Zero(field.Type()))
 		} else {
 			field.SetInt(intVal)
+			return adjustment(message)
 		}
 	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
-		uintVal, err := strconv.ParseUint(fmt.Sprintf("%v", value), 10, 64)
+		uintVal, message, err := parseUintLenient(fmt.Sprintf("%v", value))
 		if err != nil {
 			field.Set(reflect.Zero(field.Type()))
 		} else {
 			field.SetUint(uintVal)
+			return adjustment(message)
 		}
 	case reflect.Float32, reflect.Float64:
-		floatVal, err := strconv.ParseFloat(fmt.Sprintf("%v", value), 64)
+		floatVal, message, err := parseFloatLenient(fmt.Sprintf("%v", value))
 		if err != nil {
 			field.Set(reflect.Zero(field.Type()))
 		} else {
 			field.SetFloat(floatVal)
+			return adjustment(message)
 		}
 	case reflect.Bool:
-		boolVal, err := strconv.ParseBool(fmt.Sprintf("%v", value))
-		if err != nil {
-			field.SetBool(false)
-		}
+		boolVal, message, err := parseBoolLenient(fmt.Sprintf("%v", value))
 		field.SetBool(boolVal)
+		if err == nil {
+			return adjustment(message)
+		}
 	case reflect.Struct:
 		if field.Type() == reflect.TypeOf(time.Time{}) {
-			timeVal, err := time.Parse(time.RFC3339, fmt.Sprintf("%v", value))
+			timeVal, message, err := parseTimeLenient(fmt.Sprintf("%v", value))
 			if err != nil {
-				return err
+				return &ConversionError{Value: value, Type: field.Type(), Err: err}
 			}
 			field.Set(reflect.ValueOf(timeVal))
+			return adjustment(message)
 		} else {
 			// For other struct types, we'll set it to its zero value
 			field.Set(reflect.Zero(field.Type()))
@@ -145,7 +104,7 @@ func setField(field reflect.Value, value interface{}) error {
 		field.Set(reflect.Zero(field.Type()))
 		// return setSliceField(field, value)
 	default:
-		return fmt.Errorf("unsupported type: %v", field.Type())
+		return fmt.Errorf("%w: %v", ErrUnsupportedType, field.Type())
 	}
 
 	return nil
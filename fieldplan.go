@@ -0,0 +1,193 @@
+package xlsx_utilities
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// fieldPlan is the resolved path from a struct type to the field named by a header,
+// so imports look fields up once per header instead of once per cell
+type fieldPlan struct {
+	path  [][]int             // the field index chain at each struct level, as returned by reflect.Type.FieldByName
+	field reflect.Type        // the type of the target field
+	json  bool                // the target field is a JSON column
+	enum  *enumMap            // the labels of the codes of the target field, if any
+	leaf  reflect.StructField // the target field itself, for its tag options
+	err   error               // set when the header names no field
+}
+
+// planKey identifies a plan by the struct type and header
+type planKey struct {
+	t      reflect.Type
+	header string
+}
+
+// fieldPlans caches the plans of headers that name a field. Failed lookups are not cached,
+// so headers from arbitrary uploads cannot grow the cache beyond the columns of the type.
+var fieldPlans sync.Map
+
+// planField returns the plan for the header in struct type t
+func planField(t reflect.Type, header string) *fieldPlan {
+	key := planKey{t, header}
+	if plan, ok := fieldPlans.Load(key); ok {
+		return plan.(*fieldPlan)
+	}
+
+	plan := compileFieldPlan(t, header)
+	if plan.err == nil {
+		fieldPlans.Store(key, plan)
+	}
+	return plan
+}
+
+// compileFieldPlan resolves the header one struct level at a time, following pointers and slice elements
+func compileFieldPlan(t reflect.Type, header string) *fieldPlan {
+	plan := &fieldPlan{}
+	for rest := header; ; {
+		if t.Kind() == reflect.Ptr {
+			t = t.Elem()
+		}
+		if t.Kind() != reflect.Struct {
+			plan.err = fmt.Errorf("not a struct: %v", t.Kind())
+			return plan
+		}
+
+		field, next, ok := fieldByHeader(t, rest)
+		if !ok {
+			name, _, _ := strings.Cut(rest, " ")
+			plan.err = fmt.Errorf("no such field: %s in obj", name)
+			return plan
+		}
+
+		plan.path = append(plan.path, field.Index)
+		if next == "" {
+			plan.field = field.Type
+			plan.leaf = field
+			plan.json = isJSONField(field)
+			plan.enum, plan.err = enumFor(field)
+			return plan
+		}
+		t, rest = field.Type, next
+		if t.Kind() == reflect.Ptr || t.Kind() == reflect.Slice {
+			t = t.Elem()
+		}
+	}
+}
+
+// target returns the type of the target field with pointers dereferenced, or nil when the header names no field
+func (p *fieldPlan) target() reflect.Type {
+	if p.err != nil {
+		return nil
+	}
+	if p.field.Kind() == reflect.Ptr {
+		return p.field.Elem()
+	}
+	return p.field
+}
+
+// set follows the plan from the struct value v, or a pointer to it, and sets the target field from the cell value.
+// Nil pointers on the way are allocated and slices get a new element, as with nested headers.
+func (p *fieldPlan) set(v reflect.Value, value interface{}) error {
+	if p.err != nil {
+		return p.err
+	}
+
+	// an empty cell leaves nil pointers on the path nil, so a missing value can be told from a zero one
+	empty := isEmptyCell(value)
+
+	for i, index := range p.path {
+		if v.Kind() == reflect.Ptr {
+			if v.IsNil() {
+				if empty && i > 0 {
+					return nil
+				}
+				v.Set(reflect.New(v.Type().Elem()))
+			}
+			v = v.Elem()
+		}
+
+		f, err := fieldByIndex(v, index, empty)
+		if err != nil || !f.IsValid() {
+			return err
+		}
+
+		if i == len(p.path)-1 {
+			if p.enum != nil {
+				value = p.enum.code(value)
+			}
+
+			if p.json {
+				return setJSONField(f, value)
+			}
+
+			// values of the field type, such as those of a column parser, are set as they are
+			if value != nil && reflect.TypeOf(value) == f.Type() {
+				f.Set(reflect.ValueOf(value))
+				return nil
+			}
+
+			// Check if there's a custom type converter
+			if converter, ok := parserFor(f.Type()); ok {
+				convertedValue, err := converter(fmt.Sprintf("%v", value))
+				if err != nil {
+					return &ConversionError{Value: value, Type: f.Type(), Err: err}
+				}
+
+				if convertedValue == nil {
+					return nil
+				}
+
+				f.Set(reflect.ValueOf(convertedValue))
+				return nil
+			}
+
+			return setField(f, value)
+		}
+
+		switch f.Kind() {
+		case reflect.Ptr:
+			if f.IsNil() {
+				if empty {
+					return nil
+				}
+				f.Set(reflect.New(f.Type().Elem()))
+			}
+			v = f.Elem()
+		case reflect.Slice:
+			f.Set(reflect.Append(f, reflect.New(f.Type().Elem()).Elem()))
+			v = f.Index(f.Len() - 1)
+		default:
+			v = f
+		}
+	}
+
+	return nil
+}
+
+// isEmptyCell reports whether a cell value is missing or empty text
+func isEmptyCell(value interface{}) bool {
+	return value == nil || value == ""
+}
+
+// fieldByIndex returns the field at the index chain of the struct value v, allocating nil embedded pointers.
+// With skipNil, a nil embedded pointer is left as it is and the zero Value is returned.
+func fieldByIndex(v reflect.Value, index []int, skipNil bool) (reflect.Value, error) {
+	for i, x := range index {
+		if i > 0 && v.Kind() == reflect.Ptr {
+			if v.IsNil() {
+				if skipNil {
+					return reflect.Value{}, nil
+				}
+				if !v.CanSet() {
+					return reflect.Value{}, fmt.Errorf("cannot set embedded field %v", v.Type().Elem())
+				}
+				v.Set(reflect.New(v.Type().Elem()))
+			}
+			v = v.Elem()
+		}
+		v = v.Field(x)
+	}
+	return v, nil
+}
@@ -0,0 +1,86 @@
+package xlsx_utilities
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type planAddress struct {
+	City string
+}
+
+type PlanInner struct {
+	Code string
+}
+
+type planRecord struct {
+	*PlanInner
+	Name    string       `xlsx:"Full Name"`
+	Home    *planAddress `xlsx:"Home Address"`
+	Visits  []planAddress
+	Skipped string `xlsx:"-"`
+}
+
+func TestFieldPlan(t *testing.T) {
+	typ := reflect.TypeOf(planRecord{})
+
+	t.Run("Cached per type and header", func(t *testing.T) {
+		plan := planField(typ, "Home Address City")
+		assert.NoError(t, plan.err)
+		assert.Equal(t, [][]int{{2}, {0}}, plan.path)
+		assert.Same(t, plan, planField(typ, "Home Address City"))
+	})
+
+	t.Run("Failed lookups are not cached", func(t *testing.T) {
+		plan := planField(typ, "Missing")
+		assert.EqualError(t, plan.err, "no such field: Missing in obj")
+		_, cached := fieldPlans.Load(planKey{typ, "Missing"})
+		assert.False(t, cached)
+	})
+
+	t.Run("Set follows pointers, slices and embedded pointers", func(t *testing.T) {
+		var record planRecord
+		v := reflect.ValueOf(&record).Elem()
+
+		assert.NoError(t, planField(typ, "Full Name").set(v, "Ada"))
+		assert.NoError(t, planField(typ, "Home Address City").set(v, "Paris"))
+		assert.NoError(t, planField(typ, "Visits City").set(v, "Rome"))
+		assert.NoError(t, planField(typ, "Code").set(v, "X1"))
+
+		assert.Equal(t, "Ada", record.Name)
+		assert.Equal(t, &planAddress{City: "Paris"}, record.Home)
+		assert.Equal(t, []planAddress{{City: "Rome"}}, record.Visits)
+		assert.Equal(t, "X1", record.Code)
+	})
+	t.Run("Empty cells leave pointers nil", func(t *testing.T) {
+		var record planRecord
+		v := reflect.ValueOf(&record).Elem()
+
+		assert.NoError(t, planField(typ, "Home Address City").set(v, ""))
+		assert.NoError(t, planField(typ, "Code").set(v, nil))
+		assert.Nil(t, record.Home)
+		assert.Nil(t, record.PlanInner)
+	})
+}
+
+func TestEmptyPointerCells(t *testing.T) {
+	type address struct {
+		City string
+		Zip  *int
+	}
+	type row struct {
+		Count   *int
+		Address *address
+	}
+
+	excelData := NewExcelData[row]([]string{"Count", "Address City", "Address Zip"})
+	excelData.AddRow([]interface{}{"", "", ""})
+	excelData.AddRow([]interface{}{"0", "Paris", ""})
+
+	result := excelData.ToStruct()
+	assert.Empty(t, result.Errors)
+	zero := 0
+	assert.Equal(t, []row{{}, {Count: &zero, Address: &address{City: "Paris"}}}, result.Data)
+}
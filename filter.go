@@ -0,0 +1,130 @@
+package xlsx_utilities
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Row is a data row passed to predicates, with access to its values by header
+type Row struct {
+	// Index is the 0-based index of the row in the dataset
+	Index  int
+	Values []interface{}
+
+	columns map[string]int
+}
+
+// newRows returns a function wrapping the rows of ed, sharing one header index
+func (ed *ExcelData[T]) newRows() func(index int) Row {
+	columns := make(map[string]int, len(ed.Headers))
+	for i, header := range ed.Headers {
+		if _, ok := columns[header]; !ok {
+			columns[header] = i
+		}
+	}
+	return func(index int) Row {
+		return Row{Index: index, Values: ed.Rows[index], columns: columns}
+	}
+}
+
+// Get returns the value of the column, or nil when the header is unknown or the row is short.
+// Of repeated headers the first column is used.
+func (r Row) Get(header string) interface{} {
+	col, ok := r.columns[header]
+	if !ok {
+		return nil
+	}
+	return cellAt(r.Values, col)
+}
+
+// Text returns the value of the column as text, with an empty string for missing values
+func (r Row) Text(header string) string {
+	return cellText(r.Get(header))
+}
+
+// Float returns the numeric value of the column, parsing text values that hold nothing but a number
+func (r Row) Float(header string) (float64, error) {
+	switch v := r.Get(header).(type) {
+	case int:
+		return float64(v), nil
+	case int64:
+		return float64(v), nil
+	case float64:
+		return v, nil
+	case nil:
+		return 0, fmt.Errorf("column '%s' is empty", header)
+	default:
+		f, err := strconv.ParseFloat(strings.TrimSpace(cellText(v)), 64)
+		if err != nil {
+			return 0, fmt.Errorf("column '%s' is not a number: %v", header, v)
+		}
+		return f, nil
+	}
+}
+
+// Filter returns a view of the rows for which keep returns true, e.g. to skip cancelled orders before ToStruct.
+// The view shares row storage with ed.
+func (ed *ExcelData[T]) Filter(keep func(row Row) bool) *ExcelData[T] {
+	row := ed.newRows()
+
+	result := ed.view(nil)
+	for r := range ed.Rows {
+		if !keep(row(r)) {
+			continue
+		}
+		result.Rows = append(result.Rows, ed.Rows[r])
+		ed.keepLine(result, r)
+		if len(ed.Provenance) > 0 {
+			result.Provenance = append(result.Provenance, ed.rowProvenance(r))
+		}
+	}
+	return result
+}
+
+// Map replaces the values of each row by those returned by fn, which receives the row and returns its new values.
+// The returned rows must keep the header count; provenance is kept as is.
+func (ed *ExcelData[T]) Map(fn func(row Row) ([]interface{}, error)) (*ExcelData[T], error) {
+	row := ed.newRows()
+
+	rows := make([][]interface{}, len(ed.Rows))
+	for r := range ed.Rows {
+		values, err := fn(row(r))
+		if err != nil {
+			return nil, fmt.Errorf("row %d: %w", r, err)
+		}
+		if len(values) != len(ed.Headers) {
+			return nil, fmt.Errorf("row %d: row length (%d) does not match headers length (%d)", r, len(values), len(ed.Headers))
+		}
+		rows[r] = values
+	}
+
+	result := ed.view(rows)
+	result.Provenance = ed.Provenance
+	result.lines = ed.lines
+	return result, nil
+}
+
+// Sort returns a view of the rows in the order given by less; rows comparing equal keep their order
+func (ed *ExcelData[T]) Sort(less func(a, b Row) bool) *ExcelData[T] {
+	row := ed.newRows()
+
+	order := make([]int, len(ed.Rows))
+	for r := range order {
+		order[r] = r
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return less(row(order[i]), row(order[j]))
+	})
+
+	result := ed.view(make([][]interface{}, len(order)))
+	for i, r := range order {
+		result.Rows[i] = ed.Rows[r]
+		ed.keepLine(result, r)
+		if len(ed.Provenance) > 0 {
+			result.Provenance = append(result.Provenance, ed.rowProvenance(r))
+		}
+	}
+	return result
+}
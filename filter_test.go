@@ -0,0 +1,67 @@
+package xlsx_utilities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type order struct {
+	ID     int
+	Status string
+	Total  float64
+}
+
+func TestFilter(t *testing.T) {
+	excelData, err := FromStruct([]order{
+		{ID: 1, Status: "paid", Total: 120},
+		{ID: 2, Status: "cancelled", Total: 40},
+		{ID: 3, Status: "paid", Total: 15.5},
+	})
+	assert.NoError(t, err)
+
+	t.Run("Predicate by header", func(t *testing.T) {
+		active := excelData.Filter(func(row Row) bool {
+			return row.Text("Status") != "cancelled"
+		})
+		assert.Equal(t, []order{{ID: 1, Status: "paid", Total: 120}, {ID: 3, Status: "paid", Total: 15.5}}, active.ToStruct().Data)
+		assert.Len(t, excelData.Rows, 3)
+	})
+
+	t.Run("Row helpers", func(t *testing.T) {
+		var indexes []int
+		excelData.Filter(func(row Row) bool {
+			indexes = append(indexes, row.Index)
+			total, err := row.Float("Total")
+			assert.NoError(t, err)
+			assert.Equal(t, excelData.Rows[row.Index][2], total)
+			assert.Nil(t, row.Get("Missing"))
+			return false
+		})
+		assert.Equal(t, []int{0, 1, 2}, indexes)
+
+		row := Row{Values: []interface{}{"x"}, columns: map[string]int{"Total": 0}}
+		_, err := row.Float("Total")
+		assert.EqualError(t, err, "column 'Total' is not a number: x")
+
+		row.Values = []interface{}{"12abc"}
+		_, err = row.Float("Total")
+		assert.Error(t, err)
+		row.Values = []interface{}{" 12.5 "}
+		total, err := row.Float("Total")
+		assert.NoError(t, err)
+		assert.Equal(t, 12.5, total)
+	})
+
+	t.Run("Provenance follows rows", func(t *testing.T) {
+		tracked := excelData.Clone()
+		assert.NoError(t, tracked.SetProvenance(0, "Total", ProvenanceComputed))
+
+		large := tracked.Filter(func(row Row) bool {
+			total, _ := row.Float("Total")
+			return total != 40
+		})
+		assert.Equal(t, ProvenanceComputed, large.provenanceAt(0, 2))
+		assert.Equal(t, ProvenanceUser, large.provenanceAt(1, 2))
+	})
+}
@@ -0,0 +1,38 @@
+package xlsx_utilities
+
+import "strings"
+
+// formulaTriggers are the leading characters that make spreadsheet applications evaluate a text as a formula
+const formulaTriggers = "=+-@\t\r"
+
+// WithEscapeFormulas prefixes exported text starting with "=", "+", "-", "@", a tab or a carriage return,
+// so values coming from untrusted users cannot run as formulas once the file is opened or re-saved as CSV.
+// The prefix is usually "'" or " "; an empty prefix uses "'". Only string values are changed, numbers keep
+// their sign. Imports with the option remove the prefix again from such texts.
+func WithEscapeFormulas(prefix string) Option {
+	return func(c *config) {
+		if prefix == "" {
+			prefix = "'"
+		}
+		c.formulaPrefix = prefix
+	}
+}
+
+// escapeFormula prefixes the text when it would be read as a formula
+func escapeFormula(s, prefix string) string {
+	if s != "" && strings.ContainsRune(formulaTriggers, rune(s[0])) {
+		return prefix + s
+	}
+	return s
+}
+
+// unescapeFormulaRows removes the prefix added by escapeFormula from the cells in place
+func unescapeFormulaRows(rows [][]string, prefix string) {
+	for _, row := range rows {
+		for i, cell := range row {
+			if rest, ok := strings.CutPrefix(cell, prefix); ok && escapeFormula(rest, prefix) != rest {
+				row[i] = rest
+			}
+		}
+	}
+}
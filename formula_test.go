@@ -0,0 +1,48 @@
+package xlsx_utilities
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/xuri/excelize/v2"
+)
+
+func TestEscapeFormulas(t *testing.T) {
+	filename := "test_escape_formulas.xlsx"
+	defer os.Remove(filename)
+
+	type comment struct {
+		Author string
+		Text   string
+		Score  int
+	}
+	excelData, err := FromStruct([]comment{
+		{Author: "=HYPERLINK(\"http://evil\")", Text: "@SUM(A1)", Score: -3},
+		{Author: "Alice", Text: "+1 from me", Score: 5},
+	})
+	assert.NoError(t, err)
+	assert.NoError(t, excelData.Save(filename, WithEscapeFormulas("")))
+
+	f, err := excelize.OpenFile(filename)
+	assert.NoError(t, err)
+	rows, err := f.GetRows("Sheet1")
+	assert.NoError(t, err)
+	f.Close()
+	assert.Equal(t, []string{"'=HYPERLINK(\"http://evil\")", "'@SUM(A1)", "-3"}, rows[1])
+	assert.Equal(t, []string{"Alice", "'+1 from me", "5"}, rows[2])
+
+	t.Run("Prefix removed on import", func(t *testing.T) {
+		imported, err := FromExcel[comment](filename, WithEscapeFormulas(""))
+		assert.NoError(t, err)
+		result := imported.ToStruct()
+		assert.Empty(t, result.Errors)
+		assert.Equal(t, "=HYPERLINK(\"http://evil\")", result.Data[0].Author)
+		assert.Equal(t, "+1 from me", result.Data[1].Text)
+	})
+
+	t.Run("Space prefix", func(t *testing.T) {
+		assert.Equal(t, " -cmd", escapeFormula("-cmd", " "))
+		assert.Equal(t, "plain", escapeFormula("plain", " "))
+	})
+}
@@ -0,0 +1,167 @@
+package xlsx_utilities
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// AggFunc names an aggregation computed by GroupBy
+type AggFunc string
+
+// Aggregations supported by GroupBy, named as in Excel pivot tables
+const (
+	AggSum     AggFunc = "Sum"
+	AggCount   AggFunc = "Count"
+	AggAverage AggFunc = "Average"
+	AggMin     AggFunc = "Min"
+	AggMax     AggFunc = "Max"
+)
+
+// valid reports whether the aggregation is one of the supported ones
+func (fn AggFunc) valid() bool {
+	switch fn {
+	case AggSum, AggCount, AggAverage, AggMin, AggMax:
+		return true
+	}
+	return false
+}
+
+// Agg describes an aggregated column of GroupBy
+type Agg struct {
+	Func AggFunc
+	// Column is the header of the aggregated column. Count with an empty Column counts the rows.
+	Column string
+}
+
+// GroupBy returns one row per distinct combination of the key columns, in order of first appearance,
+// with the key values followed by the aggregated columns. aggs maps the header of each aggregated
+// column to its aggregation; those columns come after the keys sorted by header. Empty cells are
+// skipped; Sum, Average, Min and Max fail on values that are not numbers.
+func (ed *ExcelData[T]) GroupBy(keys []string, aggs map[string]Agg) (*ExcelData[T], error) {
+	keyCols := make([]int, len(keys))
+	for i, key := range keys {
+		if keyCols[i] = indexOf(ed.Headers, key); keyCols[i] == -1 {
+			return nil, fmt.Errorf("%w: unknown group column '%s'", ErrHeaderMismatch, key)
+		}
+	}
+
+	names := make([]string, 0, len(aggs))
+	for name := range aggs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	aggCols := make([]int, len(names))
+	for i, name := range names {
+		agg := aggs[name]
+		if !agg.Func.valid() {
+			return nil, fmt.Errorf("unknown aggregation '%s' for column '%s'", agg.Func, name)
+		}
+		aggCols[i] = -1
+		if agg.Column != "" || agg.Func != AggCount {
+			if aggCols[i] = indexOf(ed.Headers, agg.Column); aggCols[i] == -1 {
+				return nil, fmt.Errorf("%w: unknown aggregated column '%s'", ErrHeaderMismatch, agg.Column)
+			}
+		}
+	}
+
+	type group struct {
+		key    []interface{}
+		states []aggState
+	}
+	var groups []*group
+	index := make(map[string]*group)
+	for r, row := range ed.Rows {
+		k := rowKey(row, keyCols)
+		g, ok := index[k]
+		if !ok {
+			g = &group{key: make([]interface{}, len(keyCols)), states: make([]aggState, len(names))}
+			for i, col := range keyCols {
+				g.key[i] = cellAt(row, col)
+			}
+			index[k] = g
+			groups = append(groups, g)
+		}
+
+		for i, col := range aggCols {
+			if err := g.states[i].add(row, col, aggs[names[i]].Func); err != nil {
+				return nil, fmt.Errorf("row %d, column '%s': %w", r, ed.Headers[col], err)
+			}
+		}
+	}
+
+	result := ed.view(make([][]interface{}, 0, len(groups)))
+	result.Headers = append(append([]string(nil), keys...), names...)
+	for _, g := range groups {
+		values := append([]interface{}(nil), g.key...)
+		for i, name := range names {
+			values = append(values, g.states[i].result(aggs[name].Func))
+		}
+		result.Rows = append(result.Rows, values)
+	}
+	return result, nil
+}
+
+// aggState accumulates the values of one aggregated column of one group
+type aggState struct {
+	count    int
+	sum      float64
+	min, max float64
+}
+
+// add accumulates the value of the 0-based column of the row; a column of -1 counts the row
+func (s *aggState) add(row []interface{}, col int, fn AggFunc) error {
+	if col == -1 {
+		s.count++
+		return nil
+	}
+
+	value := cellAt(row, col)
+	if isEmptyCell(value) {
+		return nil
+	}
+	if fn == AggCount {
+		s.count++
+		return nil
+	}
+
+	x, ok := numericValue(value)
+	if !ok {
+		var err error
+		if x, err = strconv.ParseFloat(strings.TrimSpace(cellText(value)), 64); err != nil {
+			return fmt.Errorf("not a number: %v", value)
+		}
+	}
+	if s.count == 0 || x < s.min {
+		s.min = x
+	}
+	if s.count == 0 || x > s.max {
+		s.max = x
+	}
+	s.count++
+	s.sum += x
+	return nil
+}
+
+// result returns the aggregated value; Average, Min and Max of a group without values are empty
+func (s *aggState) result(fn AggFunc) interface{} {
+	switch fn {
+	case AggCount:
+		return s.count
+	case AggSum:
+		return s.sum
+	}
+	if s.count == 0 {
+		return nil
+	}
+	switch fn {
+	case AggAverage:
+		return s.sum / float64(s.count)
+	case AggMin:
+		return s.min
+	default:
+		return s.max
+	}
+}
@@ -0,0 +1,54 @@
+package xlsx_utilities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGroupBy(t *testing.T) {
+	excelData := NewExcelData[struct{}]([]string{"Region", "Product", "Amount"})
+	excelData.Rows = [][]interface{}{
+		{"North", "Pens", 10},
+		{"South", "Pens", 4.5},
+		{"North", "Ink", "20"},
+		{"North", "Pens", ""},
+	}
+
+	grouped, err := excelData.GroupBy([]string{"Region"}, map[string]Agg{
+		"Total":   {Func: AggSum, Column: "Amount"},
+		"Orders":  {Func: AggCount},
+		"Average": {Func: AggAverage, Column: "Amount"},
+		"Largest": {Func: AggMax, Column: "Amount"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Region", "Average", "Largest", "Orders", "Total"}, grouped.Headers)
+	assert.Equal(t, [][]interface{}{
+		{"North", 15.0, 20.0, 3, 30.0},
+		{"South", 4.5, 4.5, 1, 4.5},
+	}, grouped.Rows)
+
+	t.Run("Pipe step", func(t *testing.T) {
+		result, err := excelData.Pipe(GroupBy([]string{"Region", "Product"}, map[string]Agg{
+			"Smallest": {Func: AggMin, Column: "Amount"},
+		}))
+		assert.NoError(t, err)
+		assert.Equal(t, [][]interface{}{
+			{"North", "Pens", 10.0},
+			{"South", "Pens", 4.5},
+			{"North", "Ink", 20.0},
+		}, result.Rows)
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		_, err := excelData.GroupBy([]string{"Country"}, nil)
+		assert.ErrorIs(t, err, ErrHeaderMismatch)
+		assert.ErrorContains(t, err, "unknown group column 'Country'")
+
+		_, err = excelData.GroupBy([]string{"Region"}, map[string]Agg{"Total": {Func: AggSum, Column: "Product"}})
+		assert.ErrorContains(t, err, "row 0, column 'Product': not a number: Pens")
+
+		_, err = excelData.GroupBy([]string{"Region"}, map[string]Agg{"Total": {Func: "Median", Column: "Amount"}})
+		assert.ErrorContains(t, err, "unknown aggregation 'Median'")
+	})
+}
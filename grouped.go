@@ -0,0 +1,137 @@
+package xlsx_utilities
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// WithGroupedHeaders writes the columns of nested structs under a merged group header, e.g. "Address"
+// spanning "Street" and "City" on a second header row, instead of flattened "Address Street" headers.
+// Other columns span both header rows. Imports read such a two-row header back into flattened headers.
+// Transposed sheets are not supported.
+func WithGroupedHeaders() Option {
+	return func(c *config) {
+		c.groupedHeaders = true
+	}
+}
+
+// headerRows returns the number of header rows above the data of a sheet read
+func (c *config) headerRows() int {
+	if c.groupedHeaders {
+		return 2
+	}
+	return 1
+}
+
+// splitHeaderGroups returns the group and leaf text of each header label. Headers of fields of a
+// nested struct are split into the header of the struct and the rest; others have no group.
+func splitHeaderGroups(t reflect.Type, headers, labels []string, cfg *config) (groups, leaves []string) {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	groups = make([]string, len(headers))
+	leaves = make([]string, len(headers))
+	for i, header := range headers {
+		leaves[i] = labels[i]
+		if t.Kind() != reflect.Struct {
+			continue
+		}
+		field, rest, ok := fieldByHeader(t, header)
+		if !ok || rest == "" {
+			continue
+		}
+
+		group := fieldHeader(field)
+		if cfg.headerLanguage != "" {
+			group = fieldHeaderIn(field, languageTagPrefix+cfg.headerLanguage)
+		}
+		if leaf, ok := strings.CutPrefix(labels[i], group+" "); ok {
+			leaves[i] = leaf
+		} else if leaf, ok := strings.CutPrefix(labels[i], fieldHeader(field)+" "); ok {
+			leaves[i] = leaf
+		}
+		groups[i] = group
+	}
+	return groups, leaves
+}
+
+// writeGroupRow writes the group header row at the top of the layout and returns the layout of the
+// block below it, with the leaves to write on its header row
+func writeGroupRow(f *excelize.File, layout sheetLayout, t reflect.Type, headers, labels []string, cfg *config) (sheetLayout, []string, error) {
+	if layout.Transposed {
+		return layout, nil, fmt.Errorf("grouped headers are not supported with transposed sheets")
+	}
+
+	groups, leaves := splitHeaderGroups(t, headers, labels, cfg)
+	groupRow := layout.Row
+	layout = layout.belowGroupRow()
+
+	for i := 0; i < len(headers); {
+		end := i
+		for groups[i] != "" && end+1 < len(headers) && groups[end+1] == groups[i] {
+			end++
+		}
+
+		text := groups[i]
+		bottomRight := layout.cell(end, groupRow)
+		if text == "" {
+			// a column without a group spans both header rows
+			text = leaves[i]
+			bottomRight = layout.cell(i, layout.Row)
+		}
+		if err := f.SetCellValue(layout.Sheet, layout.cell(i, groupRow), text); err != nil {
+			return layout, nil, err
+		}
+		if bottomRight != layout.cell(i, groupRow) {
+			if err := f.MergeCell(layout.Sheet, layout.cell(i, groupRow), bottomRight); err != nil {
+				return layout, nil, err
+			}
+		}
+		i = end + 1
+	}
+
+	return layout, leaves, nil
+}
+
+// joinGroupRows replaces the group and leaf header rows with a single row of flattened headers.
+// Merged group cells hold their text in the first column only, so an empty group cell continues the
+// group on its left. A column whose leaf cell is empty or repeats the group cell has no group.
+func joinGroupRows(rows [][]string) [][]string {
+	if len(rows) < 2 {
+		return rows
+	}
+
+	groups, leaves := rows[0], rows[1]
+	width := max(len(groups), len(leaves))
+	headers := make([]string, width)
+	current := ""
+	for i := 0; i < width; i++ {
+		group, leaf := textAt(groups, i), textAt(leaves, i)
+		switch {
+		case leaf == "" || leaf == group:
+			headers[i] = group
+			current = ""
+		case group != "":
+			headers[i] = group + " " + leaf
+			current = group
+		case current != "":
+			headers[i] = current + " " + leaf
+		default:
+			headers[i] = leaf
+		}
+	}
+
+	return append([][]string{headers}, rows[2:]...)
+}
+
+// textAt returns the cell text of a row read from a sheet, which omits trailing empty cells
+func textAt(row []string, i int) string {
+	if i < len(row) {
+		return row[i]
+	}
+	return ""
+}
@@ -0,0 +1,88 @@
+package xlsx_utilities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type groupedAddress struct {
+	Street string
+	City   string
+}
+
+type groupedCustomer struct {
+	ID      int
+	Address groupedAddress
+	Note    string
+}
+
+func TestGroupedHeaders(t *testing.T) {
+	data := []groupedCustomer{
+		{ID: 1, Address: groupedAddress{Street: "Main St", City: "Paris"}, Note: "vip"},
+		{ID: 2, Address: groupedAddress{Street: "High St", City: "Rome"}},
+	}
+
+	excelData, err := FromStruct(data)
+	assert.NoError(t, err)
+
+	f, err := excelData.ToWorkbook(WithGroupedHeaders(), WithStyles(StyleSheet{Header: &Style{Bold: true}}))
+	assert.NoError(t, err)
+	defer f.Close()
+
+	t.Run("Two header rows", func(t *testing.T) {
+		rows, err := f.GetRows("Sheet1")
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"ID", "Address", "", "Note"}, rows[0])
+		assert.Equal(t, []string{"", "Street", "City"}, rows[1])
+		assert.Equal(t, []string{"1", "Main St", "Paris", "vip"}, rows[2])
+
+		merged, err := f.GetMergeCells("Sheet1")
+		assert.NoError(t, err)
+		var ranges []string
+		for _, m := range merged {
+			ranges = append(ranges, m.GetStartAxis()+":"+m.GetEndAxis())
+		}
+		assert.ElementsMatch(t, []string{"A1:A2", "B1:C1", "D1:D2"}, ranges)
+
+		id, err := f.GetCellStyle("Sheet1", "B1")
+		assert.NoError(t, err)
+		style, err := f.GetStyle(id)
+		assert.NoError(t, err)
+		assert.True(t, style.Font.Bold)
+	})
+
+	t.Run("Read back", func(t *testing.T) {
+		filename := t.TempDir() + "/grouped.xlsx"
+		assert.NoError(t, f.SaveAs(filename))
+
+		read, err := FromExcel[groupedCustomer](filename)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"ID", "Address", "", "Note"}, read.Headers)
+
+		read, err = FromExcel[groupedCustomer](filename, WithGroupedHeaders())
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"ID", "Address Street", "Address City", "Note"}, read.Headers)
+
+		result := read.ToStruct()
+		assert.Empty(t, result.Errors)
+		assert.Equal(t, data, result.Data)
+	})
+
+	t.Run("Transposed", func(t *testing.T) {
+		_, err := excelData.ToWorkbook(WithGroupedHeaders(), WithTransposed())
+		assert.ErrorContains(t, err, "grouped headers are not supported with transposed sheets")
+	})
+}
+
+func TestJoinGroupRows(t *testing.T) {
+	rows := joinGroupRows([][]string{
+		{"ID", "Address", "", "Note", "Total"},
+		{"ID", "Street", "City", "", "Net", "Gross"},
+		{"1", "Main St"},
+	})
+	assert.Equal(t, [][]string{
+		{"ID", "Address Street", "Address City", "Note", "Total Net", "Total Gross"},
+		{"1", "Main St"},
+	}, rows)
+}
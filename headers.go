@@ -10,11 +10,17 @@ func getStructHeaders(t reflect.Type) ([]string, error) {
 }
 
 func getNestedHeaders(t reflect.Type, prefix string) ([]string, error) {
+	return getNestedHeadersIn(t, prefix, tagName)
+}
+
+// getNestedHeadersIn returns the flattened headers named by the given struct tag key,
+// falling back to the xlsx tag and the field name for fields without it
+func getNestedHeadersIn(t reflect.Type, prefix, key string) ([]string, error) {
 	if t.Kind() == reflect.Ptr {
 		t = t.Elem()
 	}
 
-	if _, ok := TypeConverters[t]; ok {
+	if _, ok := converterFor(t); ok {
 		return []string{prefix}, nil
 	}
 
@@ -22,20 +28,26 @@ func getNestedHeaders(t reflect.Type, prefix string) ([]string, error) {
 		return []string{prefix}, nil
 	}
 
+	fields, err := columnFields(t)
+	if err != nil {
+		return nil, err
+	}
+
 	var headers []string
 
-	for i := 0; i < t.NumField(); i++ {
+	for _, i := range fields {
 		field := t.Field(i)
 
-		if !field.IsExported() {
-			continue
-		}
-
-		fieldName := field.Name
+		fieldName := fieldHeaderIn(field, key)
 		if prefix != "" {
 			fieldName = prefix + " " + fieldName
 		}
 
+		if isJSONField(field) {
+			headers = append(headers, fieldName)
+			continue
+		}
+
 		fieldType := field.Type
 		if fieldType.Kind() == reflect.Ptr {
 			fieldType = fieldType.Elem()
@@ -46,7 +58,7 @@ func getNestedHeaders(t reflect.Type, prefix string) ([]string, error) {
 			if fieldType == reflect.TypeOf(time.Time{}) {
 				headers = append(headers, fieldName)
 			} else {
-				nestedHeaders, err := getNestedHeaders(fieldType, fieldName)
+				nestedHeaders, err := getNestedHeadersIn(fieldType, fieldName, key)
 				if err != nil {
 					return nil, err
 				}
@@ -57,7 +69,7 @@ func getNestedHeaders(t reflect.Type, prefix string) ([]string, error) {
 			if sliceElemType.Kind() == reflect.Ptr {
 				sliceElemType = sliceElemType.Elem()
 			}
-			nestedHeaders, err := getNestedHeaders(sliceElemType, fieldName)
+			nestedHeaders, err := getNestedHeadersIn(sliceElemType, fieldName, key)
 			if err != nil {
 				return nil, err
 			}
@@ -0,0 +1,54 @@
+package xlsx_utilities
+
+import "fmt"
+
+// rowHook is a type-erased AfterReadRow hook receiving a *T
+type rowHook func(rowIndex int, item interface{}) error
+
+// WithBeforeWriteRow registers a hook called with every row before it is exported, e.g. to
+// normalize or mask values. The hook may modify row in place; the ExcelData itself is not changed.
+// rowIndex is 0-based and the values are in header order. Returning an error aborts the export.
+func WithBeforeWriteRow(hook func(rowIndex int, row []interface{}) error) Option {
+	return func(c *config) {
+		c.beforeWrite = append(c.beforeWrite, hook)
+	}
+}
+
+// WithAfterReadRow registers a hook called by ToStruct with every successfully converted item,
+// e.g. to compute derived fields or audit the import. Returning an error reports it as an
+// ImportError for the row and leaves the item out of the result.
+func WithAfterReadRow[T comparable](hook func(rowIndex int, item *T) error) Option {
+	return func(c *config) {
+		c.afterRead = append(c.afterRead, func(rowIndex int, item interface{}) error {
+			typed, ok := item.(*T)
+			if !ok {
+				return fmt.Errorf("after read hook expects %T, got %T", typed, item)
+			}
+			return hook(rowIndex, typed)
+		})
+	}
+}
+
+// beforeWrite returns the data with the BeforeWriteRow hooks applied to copies of the rows
+func (ed *ExcelData[T]) beforeWrite(cfg *config) (*ExcelData[T], error) {
+	if len(cfg.beforeWrite) == 0 {
+		return ed, nil
+	}
+
+	rows := make([][]interface{}, len(ed.Rows))
+	for rowIndex, row := range ed.Rows {
+		rows[rowIndex] = make([]interface{}, len(row))
+		copy(rows[rowIndex], row)
+
+		for _, hook := range cfg.beforeWrite {
+			if err := hook(rowIndex, rows[rowIndex]); err != nil {
+				return nil, fmt.Errorf("error in before write hook for row %d: %w", rowIndex, err)
+			}
+		}
+	}
+
+	view := ed.view(rows)
+	view.Provenance = ed.Provenance
+	view.lines = ed.lines
+	return view, nil
+}
@@ -0,0 +1,70 @@
+package xlsx_utilities
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRowHooks(t *testing.T) {
+	type user struct {
+		Name  string
+		Email string
+	}
+
+	data := []user{
+		{Name: "Alice", Email: "ALICE@EXAMPLE.COM"},
+		{Name: "Bob", Email: "bob@example.com"},
+	}
+
+	t.Run("Before write row", func(t *testing.T) {
+		excelData, err := FromStruct(data)
+		assert.NoError(t, err)
+
+		f, err := excelData.ToWorkbook(WithBeforeWriteRow(func(rowIndex int, row []interface{}) error {
+			row[1] = strings.ToLower(row[1].(string))
+			return nil
+		}))
+		assert.NoError(t, err)
+		defer f.Close()
+
+		value, _ := f.GetCellValue("Sheet1", "B2")
+		assert.Equal(t, "alice@example.com", value)
+		assert.Equal(t, "ALICE@EXAMPLE.COM", excelData.Rows[0][1])
+
+		_, err = excelData.ToWorkbook(WithBeforeWriteRow(func(rowIndex int, row []interface{}) error {
+			return fmt.Errorf("rejected")
+		}))
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "rejected")
+	})
+
+	t.Run("After read row", func(t *testing.T) {
+		filename := "test_hooks.xlsx"
+		defer os.Remove(filename)
+
+		excelData, err := FromStruct(data)
+		assert.NoError(t, err)
+		assert.NoError(t, excelData.Save(filename))
+
+		var audited []int
+		imported, err := FromExcel[user](filename, WithAfterReadRow(func(rowIndex int, item *user) error {
+			audited = append(audited, rowIndex)
+			if item.Name == "Bob" {
+				return fmt.Errorf("bob is not allowed")
+			}
+			item.Email = strings.ToLower(item.Email)
+			return nil
+		}))
+		assert.NoError(t, err)
+
+		result := imported.ToStruct()
+		assert.Equal(t, []int{0, 1}, audited)
+		assert.Equal(t, []user{{Name: "Alice", Email: "alice@example.com"}}, result.Data)
+		assert.Len(t, result.Errors, 1)
+		assert.Equal(t, "Row 3: bob is not allowed", result.Errors[0].Error())
+	})
+}
@@ -0,0 +1,142 @@
+package xlsx_utilities
+
+import (
+	"bufio"
+	"fmt"
+	"html"
+	"io"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// ToHTML writes the headers and rows as an HTML table, e.g. for email bodies or web previews of a report.
+// With WithTheme or WithStyles, the cells carry inline styles mirroring the export; number formats,
+// tables, charts and the other workbook decorations are xlsx only.
+func (ed *ExcelData[T]) ToHTML(w io.Writer, opts ...Option) error {
+	cfg := ed.config(opts...)
+
+	ed, err := ed.beforeWrite(cfg)
+	if err != nil {
+		return err
+	}
+	ed = ed.forViewer(cfg)
+
+	ss, err := cfg.styleSheet()
+	if err != nil {
+		return err
+	}
+	itemStyles, err := ed.itemStyles(cfg)
+	if err != nil {
+		return err
+	}
+	styled := ss != nil || itemStyles != nil
+	if ss == nil {
+		ss = &StyleSheet{}
+	}
+	base := Style{}.merge(ss.Workbook).merge(ss.Sheet)
+	columns := make([]Style, len(ed.Headers))
+	for i, header := range ed.Headers {
+		columns[i] = base.merge(ss.Columns[header])
+	}
+
+	b := bufio.NewWriter(w)
+	if styled {
+		b.WriteString(`<table style="border-collapse:collapse">`)
+	} else {
+		b.WriteString(`<table>`)
+	}
+
+	if !cfg.skipHeaders {
+		b.WriteString(`<thead><tr>`)
+		for i, label := range headerLabels(reflect.TypeOf((*T)(nil)).Elem(), ed.Headers, cfg) {
+			writeHTMLCell(b, "th", label, columns[i].merge(ss.Header), styled)
+		}
+		b.WriteString(`</tr></thead>`)
+	}
+
+	b.WriteString(`<tbody>`)
+	for rowIndex, row := range ed.Rows {
+		var rowStyle *Style
+		if ss.Row != nil {
+			rowStyle = ss.Row(rowIndex, row)
+		}
+		if itemStyles != nil {
+			rowStyle = overlay(rowStyle, itemStyles[rowIndex])
+		}
+
+		b.WriteString(`<tr>`)
+		for i := range ed.Headers {
+			value := cellAt(row, i)
+			if s, ok := value.(string); ok && cfg.normalizeText {
+				value = normalizeText(s)
+			}
+			writeHTMLCell(b, "td", localizeValue(value, cfg), columns[i].merge(rowStyle), styled)
+		}
+		b.WriteString(`</tr>`)
+	}
+	b.WriteString(`</tbody></table>`)
+
+	return b.Flush()
+}
+
+// writeHTMLCell writes a single th or td element, with the style inline when styled
+func writeHTMLCell(b *bufio.Writer, tag string, value interface{}, style Style, styled bool) {
+	b.WriteString("<" + tag)
+	if css := style.css(); styled && css != "" {
+		b.WriteString(` style="` + html.EscapeString(css) + `"`)
+	}
+	b.WriteString(">")
+
+	text := cellText(value)
+	if t, ok := value.(time.Time); ok {
+		text = t.Format(time.DateTime)
+		if t.Hour() == 0 && t.Minute() == 0 && t.Second() == 0 {
+			text = t.Format(time.DateOnly)
+		}
+	}
+	b.WriteString(strings.ReplaceAll(html.EscapeString(text), "\n", "<br>"))
+	b.WriteString("</" + tag + ">")
+}
+
+// css returns the inline CSS declarations of the style
+func (s Style) css() string {
+	var decls []string
+	if s.Bold {
+		decls = append(decls, "font-weight:bold")
+	}
+	if s.Italic {
+		decls = append(decls, "font-style:italic")
+	}
+	if s.FontFamily != "" {
+		decls = append(decls, "font-family:"+s.FontFamily)
+	}
+	if s.FontSize != 0 {
+		decls = append(decls, fmt.Sprintf("font-size:%gpt", s.FontSize))
+	}
+	if s.FontColor != "" {
+		decls = append(decls, "color:"+cssColor(s.FontColor))
+	}
+	if s.FillColor != "" {
+		decls = append(decls, "background-color:"+cssColor(s.FillColor))
+	}
+	switch s.HAlign {
+	case "left", "center", "right", "justify":
+		decls = append(decls, "text-align:"+s.HAlign)
+	}
+	switch s.VAlign {
+	case "top", "bottom":
+		decls = append(decls, "vertical-align:"+s.VAlign)
+	case "center":
+		decls = append(decls, "vertical-align:middle")
+	}
+	if s.BorderColor != "" {
+		decls = append(decls, "border:1px solid "+cssColor(s.BorderColor))
+	}
+	return strings.Join(decls, ";")
+}
+
+// cssColor returns the hex color of a style, given with or without the leading '#'
+func cssColor(color string) string {
+	return "#" + strings.TrimPrefix(color, "#")
+}
@@ -0,0 +1,34 @@
+package xlsx_utilities
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToHTML(t *testing.T) {
+	excelData, err := FromStruct([]person{{Name: "Alice <Admin>", Age: 30}, {Name: "Bob", Age: 25}})
+	assert.NoError(t, err)
+
+	var plain bytes.Buffer
+	assert.NoError(t, excelData.ToHTML(&plain))
+	assert.Equal(t, `<table><thead><tr><th>Name</th><th>Age</th></tr></thead>`+
+		`<tbody><tr><td>Alice &lt;Admin&gt;</td><td>30</td></tr><tr><td>Bob</td><td>25</td></tr></tbody></table>`, plain.String())
+
+	t.Run("Theme styles", func(t *testing.T) {
+		var styled bytes.Buffer
+		assert.NoError(t, excelData.ToHTML(&styled, WithTheme("striped"), WithoutHeaders()))
+		assert.Equal(t, `<table style="border-collapse:collapse"><tbody>`+
+			`<tr><td>Alice &lt;Admin&gt;</td><td>30</td></tr>`+
+			`<tr><td style="background-color:#F2F2F2">Bob</td><td style="background-color:#F2F2F2">25</td></tr>`+
+			`</tbody></table>`, styled.String())
+	})
+
+	t.Run("Header style", func(t *testing.T) {
+		var styled bytes.Buffer
+		assert.NoError(t, excelData.ToHTML(&styled, WithTheme("report")))
+		assert.Contains(t, styled.String(), `<th style="font-weight:bold;font-family:Calibri;font-size:11pt;color:#FFFFFF;`+
+			`background-color:#1F4E78;text-align:center;border:1px solid #BFBFBF">Name</th>`)
+	})
+}
@@ -0,0 +1,41 @@
+package xlsx_utilities
+
+import (
+	"mime"
+	"net/http"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// ContentType is the MIME type of xlsx workbooks
+const ContentType = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+
+// WriteHTTP generates the workbook and writes it as a file download with the given filename.
+// Nothing is written to w when the workbook cannot be generated, so the caller can still send an error response.
+func (ed *ExcelData[T]) WriteHTTP(w http.ResponseWriter, filename string, opts ...Option) error {
+	f, err := ed.ToWorkbook(opts...)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	return writeHTTP(w, f, filename)
+}
+
+// WriteHTTP finishes the stream and writes the workbook as a file download with the given filename
+func (w *StreamWriter[T]) WriteHTTP(rw http.ResponseWriter, filename string) error {
+	defer w.f.Close()
+
+	if err := w.Close(); err != nil {
+		return err
+	}
+	return writeHTTP(rw, w.f, filename)
+}
+
+// writeHTTP sets the download headers and writes the workbook to the response
+func writeHTTP(w http.ResponseWriter, f *excelize.File, filename string) error {
+	w.Header().Set("Content-Type", ContentType)
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": filename}))
+	_, err := f.WriteTo(w)
+	return err
+}
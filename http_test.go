@@ -0,0 +1,46 @@
+package xlsx_utilities
+
+import (
+	"bytes"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWriteHTTP(t *testing.T) {
+	excelData, err := FromStruct([]person{{Name: "Alice", Age: 30}})
+	assert.NoError(t, err)
+
+	t.Run("Download", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		assert.NoError(t, excelData.WriteHTTP(rec, "people report.xlsx"))
+
+		assert.Equal(t, ContentType, rec.Header().Get("Content-Type"))
+		assert.Equal(t, `attachment; filename="people report.xlsx"`, rec.Header().Get("Content-Disposition"))
+
+		imported, err := FromFileExcel[person](bytes.NewReader(rec.Body.Bytes()))
+		assert.NoError(t, err)
+		assert.Equal(t, []interface{}{"Alice", 30}, imported.Rows[0])
+	})
+
+	t.Run("Nothing written on error", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		assert.Error(t, excelData.WriteHTTP(rec, "people.xlsx", WithAnchor("invalid")))
+		assert.Empty(t, rec.Header().Get("Content-Type"))
+		assert.Zero(t, rec.Body.Len())
+	})
+
+	t.Run("Stream", func(t *testing.T) {
+		w, err := NewStreamWriter[person](nil)
+		assert.NoError(t, err)
+		assert.NoError(t, w.WriteStruct(person{Name: "Bob", Age: 25}))
+
+		rec := httptest.NewRecorder()
+		assert.NoError(t, w.WriteHTTP(rec, "people.xlsx"))
+
+		imported, err := FromFileExcel[person](bytes.NewReader(rec.Body.Bytes()))
+		assert.NoError(t, err)
+		assert.Equal(t, []interface{}{"Bob", 25}, imported.Rows[0])
+	})
+}
@@ -0,0 +1,186 @@
+package xlsx_utilities
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// languageTagPrefix starts the struct tags naming the column of a field in a language, e.g. `xlsx_fr:"Nom"`
+const languageTagPrefix = tagName + "_"
+
+// WithHeaderLanguage writes the headers in a language, taken from the xlsx_<lang> tags of the fields,
+// e.g. `xlsx:"Name" xlsx_fr:"Nom"` for "fr". Fields without a tag for the language keep their header.
+// Imports accept the headers of every language tagged on the struct regardless of this option.
+func WithHeaderLanguage(lang string) Option {
+	return func(c *config) {
+		c.headerLanguage = lang
+	}
+}
+
+// WithHeaderTranslations writes headers under translated names, keyed by the header they replace, e.g.
+// {"Address City": "Ville"}. They take precedence over the language tags, and are accepted on import.
+func WithHeaderTranslations(translations map[string]string) Option {
+	return func(c *config) {
+		if c.headerTranslations == nil {
+			c.headerTranslations = make(map[string]string)
+		}
+		for header, translation := range translations {
+			c.headerTranslations[header] = translation
+		}
+	}
+}
+
+// headerLabels returns the text written for each header of struct type t in the configured language
+func headerLabels(t reflect.Type, headers []string, cfg *config) []string {
+	if cfg.headerLanguage == "" && len(cfg.headerTranslations) == 0 {
+		return headers
+	}
+
+	var localized map[string]string
+	if cfg.headerLanguage != "" {
+		localized = languageHeaders(t)[cfg.headerLanguage]
+	}
+
+	labels := make([]string, len(headers))
+	for i, header := range headers {
+		labels[i] = header
+		if label, ok := localized[header]; ok {
+			labels[i] = label
+		}
+		if label, ok := cfg.headerTranslations[header]; ok {
+			labels[i] = label
+		}
+	}
+	return labels
+}
+
+// canonicalHeaders returns the headers with translated ones replaced by the header of their field.
+// Headers that already name a field are kept, so a translation cannot shadow another column.
+func canonicalHeaders(t reflect.Type, headers []string, cfg *config) []string {
+	languages := languageHeaders(t)
+	if len(languages) == 0 && len(cfg.headerTranslations) == 0 {
+		return headers
+	}
+
+	canonical := make(map[string]string)
+	for _, localized := range languages {
+		for header, label := range localized {
+			canonical[label] = header
+		}
+	}
+	for header, label := range cfg.headerTranslations {
+		canonical[label] = header
+	}
+
+	result := make([]string, len(headers))
+	for i, header := range headers {
+		result[i] = header
+		if original, ok := canonical[header]; ok && planField(t, header).err != nil {
+			result[i] = original
+		}
+	}
+	return result
+}
+
+// languageHeadersCache caches the headers per language of each struct type
+var languageHeadersCache sync.Map
+
+// languageHeaders returns, per language tagged on the fields of struct type t, the localized
+// header of every header it changes
+func languageHeaders(t reflect.Type) map[string]map[string]string {
+	if cached, ok := languageHeadersCache.Load(t); ok {
+		return cached.(map[string]map[string]string)
+	}
+
+	languages := make(map[string]map[string]string)
+	for _, lang := range tagLanguages(t, map[reflect.Type]bool{}) {
+		headers, err := getNestedHeadersIn(t, "", languageTagPrefix+lang)
+		if err != nil {
+			continue
+		}
+		canonical, err := getStructHeaders(t)
+		if err != nil || len(canonical) != len(headers) {
+			continue
+		}
+		localized := make(map[string]string)
+		for i, header := range canonical {
+			if headers[i] != header {
+				localized[header] = headers[i]
+			}
+		}
+		languages[lang] = localized
+	}
+
+	languageHeadersCache.Store(t, languages)
+	return languages
+}
+
+// tagLanguages returns the languages of the xlsx_<lang> tags on the fields of t and its nested structs
+func tagLanguages(t reflect.Type, seen map[reflect.Type]bool) []string {
+	for t.Kind() == reflect.Ptr || t.Kind() == reflect.Slice {
+		t = t.Elem()
+	}
+	if !isNestedStruct(t) || seen[t] {
+		return nil
+	}
+	seen[t] = true
+
+	var languages []string
+	add := func(lang string) {
+		for _, l := range languages {
+			if l == lang {
+				return
+			}
+		}
+		languages = append(languages, lang)
+	}
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !isColumnField(field) {
+			continue
+		}
+		for _, key := range tagKeys(field.Tag) {
+			if lang, ok := strings.CutPrefix(key, languageTagPrefix); ok && lang != "" {
+				add(lang)
+			}
+		}
+		for _, lang := range tagLanguages(field.Type, seen) {
+			add(lang)
+		}
+	}
+	return languages
+}
+
+// tagKeys returns the keys of a struct tag in the conventional `key:"value" key2:"value2"` format
+func tagKeys(tag reflect.StructTag) []string {
+	var keys []string
+	for tag != "" {
+		tag = reflect.StructTag(strings.TrimLeft(string(tag), " "))
+		key, rest, ok := strings.Cut(string(tag), ":")
+		if !ok || key == "" || strings.ContainsAny(key, " \"") || !strings.HasPrefix(rest, `"`) {
+			break
+		}
+
+		// find the closing quote of the value, skipping escaped characters
+		i := 1
+		for i < len(rest) && rest[i] != '"' {
+			if rest[i] == '\\' {
+				i++
+			}
+			i++
+		}
+		if i >= len(rest) {
+			break
+		}
+		if _, err := strconv.Unquote(rest[:i+1]); err != nil {
+			break
+		}
+
+		keys = append(keys, key)
+		tag = reflect.StructTag(rest[i+1:])
+	}
+	return keys
+}
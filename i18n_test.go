@@ -0,0 +1,71 @@
+package xlsx_utilities
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type i18nAddress struct {
+	City string `xlsx:"City" xlsx_de:"Stadt" xlsx_fr:"Ville"`
+}
+
+type i18nCustomer struct {
+	Name    string      `xlsx:"Name" xlsx_fr:"Nom"`
+	Age     int         `xlsx:"Age" xlsx_de:"Alter" xlsx_fr:"Âge"`
+	Address i18nAddress `xlsx:"Address" xlsx_de:"Adresse"`
+}
+
+func TestHeaderLanguages(t *testing.T) {
+	data := []i18nCustomer{{Name: "Alice", Age: 30, Address: i18nAddress{City: "Paris"}}}
+
+	t.Run("Export in a language", func(t *testing.T) {
+		excelData, err := FromStruct(data)
+		assert.NoError(t, err)
+
+		f, err := excelData.ToWorkbook(WithHeaderLanguage("de"))
+		assert.NoError(t, err)
+		defer f.Close()
+
+		rows, err := f.GetRows("Sheet1")
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"Name", "Alter", "Adresse Stadt"}, rows[0])
+		assert.Equal(t, []string{"Name", "Age", "Address City"}, excelData.Headers)
+	})
+
+	t.Run("Translations take precedence", func(t *testing.T) {
+		labels := headerLabels(reflect.TypeOf(i18nCustomer{}), []string{"Name", "Age", "Address City"},
+			newConfig([]Option{WithHeaderLanguage("fr"), WithHeaderTranslations(map[string]string{"Age": "Années"})}))
+		assert.Equal(t, []string{"Nom", "Années", "Address Ville"}, labels)
+	})
+
+	t.Run("Import matches any language", func(t *testing.T) {
+		for _, headers := range [][]string{
+			{"Name", "Alter", "Adresse Stadt"},
+			{"Nom", "Âge", "Address Ville"},
+			{"Name", "Age", "Address City"},
+		} {
+			excelData := NewExcelData[i18nCustomer](headers)
+			excelData.AddRow([]interface{}{"Alice", "30", "Paris"})
+
+			result := excelData.ToStruct()
+			assert.Empty(t, result.Errors)
+			assert.Equal(t, data, result.Data, headers)
+		}
+	})
+
+	t.Run("Import with translations", func(t *testing.T) {
+		excelData := NewExcelData[i18nCustomer]([]string{"Cliente", "Age", "Address City"})
+		excelData.AddRow([]interface{}{"Alice", "30", "Paris"})
+
+		result := excelData.WithOptions(WithHeaderTranslations(map[string]string{"Name": "Cliente"})).ToStruct()
+		assert.Empty(t, result.Errors)
+		assert.Equal(t, data, result.Data)
+	})
+
+	t.Run("Tag keys", func(t *testing.T) {
+		assert.Equal(t, []string{"xlsx", "xlsx_fr", "json"}, tagKeys(`xlsx:"Name,omitempty" xlsx_fr:"Nom \"x\"" json:"name"`))
+		assert.Equal(t, []string{"xlsx"}, tagKeys(`xlsx:"Name" broken`))
+	})
+}
@@ -0,0 +1,297 @@
+package xlsx_utilities
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// columnKind is the kind of values a template column expects
+type columnKind int
+
+const (
+	kindOther columnKind = iota
+	kindText
+	kindWhole
+	kindDecimal
+	kindBool
+	kindDate
+	kindChoice
+	kindJSON
+)
+
+// columnHint describes the valid values of a template column, from the type and tag options of its field
+type columnHint struct {
+	kind     columnKind
+	min, max *float64
+	choices  []string
+}
+
+// fieldHint returns the hint of the field named by the header in struct type t
+func fieldHint(t reflect.Type, header string) columnHint {
+	plan := planField(t, header)
+	target := plan.target()
+	if target == nil {
+		return columnHint{}
+	}
+
+	hint := columnHint{}
+	for key, bound := range map[string]**float64{"min": &hint.min, "max": &hint.max} {
+		if value, ok := tagOption(plan.leaf, key); ok {
+			if n, err := strconv.ParseFloat(value, 64); err == nil {
+				*bound = &n
+			}
+		}
+	}
+
+	switch {
+	case plan.json:
+		hint.kind = kindJSON
+	case plan.enum != nil:
+		hint.kind, hint.choices = kindChoice, plan.enum.sortedLabels()
+	case target == reflect.TypeOf(time.Time{}):
+		hint.kind = kindDate
+	default:
+		if option, ok := tagOption(plan.leaf, "oneof"); ok {
+			hint.kind, hint.choices = kindChoice, strings.Split(option, ";")
+			break
+		}
+		switch target.Kind() {
+		case reflect.String:
+			hint.kind = kindText
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			hint.kind = kindWhole
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			hint.kind = kindWhole
+			if hint.min == nil {
+				zero := 0.0
+				hint.min = &zero
+			}
+		case reflect.Float32, reflect.Float64:
+			hint.kind = kindDecimal
+		case reflect.Bool:
+			hint.kind, hint.choices = kindBool, []string{"TRUE", "FALSE"}
+		}
+	}
+	return hint
+}
+
+// sortedLabels returns the labels ordered by their codes, numerically when the codes are numbers
+func (m *enumMap) sortedLabels() []string {
+	codes := make([]string, 0, len(m.labels))
+	for code := range m.labels {
+		codes = append(codes, code)
+	}
+	sort.Slice(codes, func(a, b int) bool {
+		x, errX := strconv.ParseFloat(codes[a], 64)
+		y, errY := strconv.ParseFloat(codes[b], 64)
+		if errX == nil && errY == nil {
+			return x < y
+		}
+		return codes[a] < codes[b]
+	})
+
+	labels := make([]string, len(codes))
+	for i, code := range codes {
+		labels[i] = m.labels[code]
+	}
+	return labels
+}
+
+// describe returns the text shown in the comment of the header, e.g. "Whole number between 1 and 10"
+func (h columnHint) describe() string {
+	var what string
+	switch h.kind {
+	case kindText:
+		if h.min == nil && h.max == nil {
+			return "Text"
+		}
+		what = "Text with a length"
+	case kindWhole:
+		what = "Whole number"
+	case kindDecimal:
+		what = "Number"
+	case kindBool:
+		return "TRUE or FALSE"
+	case kindDate:
+		return "Date, e.g. 2024-01-31"
+	case kindChoice:
+		return "One of: " + strings.Join(h.choices, ", ")
+	case kindJSON:
+		return `JSON, e.g. {"key": "value"}`
+	default:
+		return ""
+	}
+
+	switch {
+	case h.min != nil && h.max != nil:
+		return fmt.Sprintf("%s between %g and %g", what, *h.min, *h.max)
+	case h.min != nil:
+		return fmt.Sprintf("%s of at least %g", what, *h.min)
+	case h.max != nil:
+		return fmt.Sprintf("%s of at most %g", what, *h.max)
+	}
+	return what
+}
+
+// example returns a valid example value for the column
+func (h columnHint) example() interface{} {
+	switch h.kind {
+	case kindText:
+		return h.fitLength("Example")
+	case kindWhole:
+		return int(math.Ceil(h.clamp(1)))
+	case kindDecimal:
+		return h.clamp(1.5)
+	case kindBool:
+		return true
+	case kindDate:
+		return time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC)
+	case kindChoice:
+		if len(h.choices) > 0 {
+			return h.choices[0]
+		}
+	case kindJSON:
+		return `{"key": "value"}`
+	}
+	return nil
+}
+
+// fitLength pads or cuts the text to a length within the bounds of the hint
+func (h columnHint) fitLength(text string) string {
+	if h.min != nil && len(text) < int(*h.min) {
+		text += strings.Repeat("x", int(*h.min)-len(text))
+	}
+	if h.max != nil && len(text) > int(*h.max) {
+		text = text[:max(int(*h.max), 0)]
+	}
+	return text
+}
+
+// clamp returns the value moved within the bounds of the hint
+func (h columnHint) clamp(value float64) float64 {
+	if h.min != nil && value < *h.min {
+		value = *h.min
+	}
+	if h.max != nil && value > *h.max {
+		value = *h.max
+	}
+	return value
+}
+
+// validation returns the data validation of the column, or nil when its values are not restricted
+func (h columnHint) validation() (*excelize.DataValidation, error) {
+	dv := excelize.NewDataValidation(true)
+	bound := func(p *float64, fallback float64) float64 {
+		if p != nil {
+			return *p
+		}
+		return fallback
+	}
+
+	var err error
+	switch h.kind {
+	case kindChoice, kindBool:
+		err = dv.SetDropList(h.choices)
+	case kindWhole:
+		// unbounded columns accept the whole numbers Excel stores exactly, as for decimals
+		err = dv.SetRange(int(bound(h.min, -1e15)), int(bound(h.max, 1e15)),
+			excelize.DataValidationTypeWhole, excelize.DataValidationOperatorBetween)
+	case kindDecimal:
+		err = dv.SetRange(bound(h.min, -1e15), bound(h.max, 1e15),
+			excelize.DataValidationTypeDecimal, excelize.DataValidationOperatorBetween)
+	case kindText:
+		if h.min == nil && h.max == nil {
+			return nil, nil
+		}
+		err = dv.SetRange(int(bound(h.min, 0)), int(bound(h.max, excelize.TotalCellChars)),
+			excelize.DataValidationTypeTextLength, excelize.DataValidationOperatorBetween)
+	default:
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	dv.SetError(excelize.DataValidationErrorStyleStop, "Invalid value", h.describe())
+	return dv, nil
+}
+
+// NewImportTemplate returns an import template for T: a workbook with the headers of T, a data validation
+// on each column whose values are restricted by the field type or the min, max, oneof and map tag
+// options, a comment on each header describing the expected values, and an example row, or the rows
+// of WithSampleRows.
+// Export options such as WithSheet, WithTheme or WithHeaderLanguage apply as usual.
+func NewImportTemplate[T comparable](opts ...Option) (*excelize.File, error) {
+	t := reflect.TypeOf((*T)(nil)).Elem()
+	headers, err := getStructHeaders(t)
+	if err != nil {
+		return nil, fmt.Errorf("error getting headers: %w", err)
+	}
+
+	hints := make([]columnHint, len(headers))
+	for i, header := range headers {
+		hints[i] = fieldHint(t, header)
+	}
+
+	ed := NewExcelData[T](headers)
+	if n := newConfig(opts).sampleRows; n > 0 {
+		ed.Rows = sampleRows(headers, hints, n)
+	} else {
+		example := make([]interface{}, len(headers))
+		for i, hint := range hints {
+			example[i] = hint.example()
+		}
+		ed.Rows = append(ed.Rows, example)
+	}
+	return ed.ToWorkbook(append(opts, func(c *config) { c.templateHints = true })...)
+}
+
+// GenerateTemplate saves the import template of T, as returned by NewImportTemplate, to filename
+func GenerateTemplate[T comparable](filename string, opts ...Option) error {
+	f, err := NewImportTemplate[T](opts...)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	return saveWorkbook(f, filename, newConfig(opts))
+}
+
+// applyTemplateHints adds the data validations and header comments of an import template
+func applyTemplateHints(f *excelize.File, layout sheetLayout, cfg *config, t reflect.Type, headers []string) error {
+	if !cfg.templateHints {
+		return nil
+	}
+	if layout.Transposed {
+		return fmt.Errorf("templates are not supported with transposed sheets")
+	}
+
+	for col, header := range headers {
+		hint := fieldHint(t, header)
+
+		dv, err := hint.validation()
+		if err != nil {
+			return fmt.Errorf("column '%s': %w", header, err)
+		}
+		if dv != nil {
+			dv.Sqref = fmt.Sprintf("%s:%s", layout.cell(col, layout.firstDataRow()), layout.cell(col, excelize.TotalRows))
+			if err := f.AddDataValidation(layout.Sheet, dv); err != nil {
+				return err
+			}
+		}
+
+		if text := hint.describe(); text != "" && layout.HeaderRow {
+			comment := excelize.Comment{Cell: layout.cell(col, layout.Row), Author: "Template", Text: text}
+			if err := f.AddComment(layout.Sheet, comment); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
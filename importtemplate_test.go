@@ -0,0 +1,79 @@
+package xlsx_utilities
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/xuri/excelize/v2"
+)
+
+type templateOrder struct {
+	Code     string    `xlsx:"Code,min=3,max=8"`
+	Quantity int       `xlsx:"Quantity,min=1,max=100"`
+	Price    float64   `xlsx:"Price,min=0"`
+	Channel  string    `xlsx:"Channel,oneof=Web;Store"`
+	Status   int       `xlsx:"Status,map=1:Open;2:Closed"`
+	Paid     bool      `xlsx:"Paid"`
+	Due      time.Time `xlsx:"Due"`
+}
+
+func TestGenerateTemplate(t *testing.T) {
+	filename := "test_import_template.xlsx"
+	defer os.Remove(filename)
+
+	assert.NoError(t, GenerateTemplate[templateOrder](filename))
+
+	f, err := excelize.OpenFile(filename)
+	assert.NoError(t, err)
+	defer f.Close()
+
+	rows, err := f.GetRows("Sheet1")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Code", "Quantity", "Price", "Channel", "Status", "Paid", "Due"}, rows[0])
+	assert.Len(t, rows, 2)
+
+	comments, err := f.GetComments("Sheet1")
+	assert.NoError(t, err)
+	texts := map[string]string{}
+	for _, comment := range comments {
+		texts[comment.Cell] = comment.Text
+	}
+	assert.Equal(t, map[string]string{
+		"A1": "Text with a length between 3 and 8",
+		"B1": "Whole number between 1 and 100",
+		"C1": "Number of at least 0",
+		"D1": "One of: Web, Store",
+		"E1": "One of: Open, Closed",
+		"F1": "TRUE or FALSE",
+		"G1": "Date, e.g. 2024-01-31",
+	}, texts)
+
+	validations, err := f.GetDataValidations("Sheet1")
+	assert.NoError(t, err)
+	ranges := map[string]string{}
+	for _, dv := range validations {
+		ranges[dv.Sqref] = dv.Type
+	}
+	assert.Equal(t, "whole", ranges["B2:B1048576"])
+	assert.Equal(t, "list", ranges["E2:E1048576"])
+	assert.Len(t, validations, 6)
+
+	t.Run("Unbounded whole numbers", func(t *testing.T) {
+		dv, err := columnHint{kind: kindWhole}.validation()
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"-1000000000000000", "1000000000000000"}, []string{dv.Formula1, dv.Formula2})
+	})
+
+	t.Run("Example row imports", func(t *testing.T) {
+		imported, err := FromExcel[templateOrder](filename)
+		assert.NoError(t, err)
+		result := imported.ToStruct()
+		assert.Empty(t, result.Errors)
+		assert.Equal(t, templateOrder{
+			Code: "Example", Quantity: 1, Price: 1.5, Channel: "Web", Status: 1, Paid: true,
+			Due: time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC),
+		}, result.Data[0])
+	})
+}
@@ -0,0 +1,31 @@
+package xlsx_utilities
+
+// WithRawStrings keeps the cells read as the text Excel displays instead of inferring ints, floats
+// and booleans from it, so identifiers like "007" or "1e5" survive in string fields. Fields of other
+// types still parse the text when the rows are converted with ToStruct. Columns typed by a schema
+// are parsed as usual. Combined with WithStrictTypes, number fields accept the texts that parse as numbers.
+func WithRawStrings() Option {
+	return func(c *config) {
+		WithCellParser(func(text string) interface{} {
+			return text
+		})(c)
+		c.rawStrings = true
+	}
+}
+
+// WithCellParser replaces the inference of cell values when reading, which turns the text of each
+// cell into an int, float64, bool or string. Columns typed by a schema are not passed to it.
+func WithCellParser(parse func(text string) interface{}) Option {
+	return func(c *config) {
+		c.cellParser = parse
+		c.rawStrings = false
+	}
+}
+
+// parseCell returns the value of a cell read from its text, with the configured parser if any
+func (c *config) parseCell(text string) interface{} {
+	if c.cellParser != nil {
+		return c.cellParser(text)
+	}
+	return convertCellValue(text)
+}
@@ -0,0 +1,55 @@
+package xlsx_utilities
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/xuri/excelize/v2"
+)
+
+func TestCellInference(t *testing.T) {
+	type item struct {
+		SKU      string
+		Quantity int
+		Price    float64
+		Active   bool
+	}
+	filename := "test_cell_inference.xlsx"
+	defer os.Remove(filename)
+
+	f := excelize.NewFile()
+	assert.NoError(t, f.SetSheetRow("Sheet1", "A1", &[]interface{}{"SKU", "Quantity", "Price", "Active"}))
+	assert.NoError(t, f.SetSheetRow("Sheet1", "A2", &[]interface{}{"007", "3", "1.5", "TRUE"}))
+	assert.NoError(t, f.SetSheetRow("Sheet1", "A3", &[]interface{}{"1e5", "12", "2", "false"}))
+	assert.NoError(t, f.SaveAs(filename))
+	f.Close()
+
+	t.Run("Inferred", func(t *testing.T) {
+		excelData, err := FromExcel[item](filename)
+		assert.NoError(t, err)
+		assert.Equal(t, 7, excelData.Rows[0][0])
+		assert.Equal(t, 100000.0, excelData.Rows[1][0])
+	})
+
+	t.Run("RawStrings", func(t *testing.T) {
+		excelData, err := FromExcel[item](filename, WithRawStrings())
+		assert.NoError(t, err)
+		assert.Equal(t, []interface{}{"007", "3", "1.5", "TRUE"}, excelData.Rows[0])
+
+		result := excelData.ToStruct()
+		assert.Empty(t, result.Errors)
+		assert.Equal(t, []item{{"007", 3, 1.5, true}, {"1e5", 12, 2, false}}, result.Data)
+	})
+
+	t.Run("CellParser", func(t *testing.T) {
+		upper := WithCellParser(func(text string) interface{} {
+			return strings.ToUpper(text)
+		})
+		excelData, err := FromExcel[item](filename, upper)
+		assert.NoError(t, err)
+		assert.Equal(t, "1E5", excelData.Rows[1][0])
+		assert.Equal(t, "FALSE", excelData.Rows[1][3])
+	})
+}
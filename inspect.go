@@ -0,0 +1,78 @@
+package xlsx_utilities
+
+import "github.com/xuri/excelize/v2"
+
+// SheetInfo describes a sheet without parsing its cells
+type SheetInfo struct {
+	Name    string
+	Visible bool
+	// Dimension is the used range declared by the file, e.g. "A1:D120"; it can be missing or stale
+	Dimension string
+	// Rows is the number of rows up to the last row present in the sheet, headers included
+	Rows int
+	// Cols is the number of columns up to the last non-empty cell of the widest row
+	Cols int
+}
+
+// Inspect returns the sheets of a workbook with their dimensions and row counts. Sheets are
+// streamed row by row without building a dataset, so upload endpoints can reject oversized
+// files before a full import. The limits of WithMaxFileSize and WithMaxUncompressedSize are checked
+// before the workbook is opened; the counts are returned to compare with the row and column limits.
+func Inspect(filename string, opts ...Option) ([]SheetInfo, error) {
+	f, err := openExcelFile(filename, newConfig(opts))
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return inspectWorkbook(f)
+}
+
+// inspectWorkbook collects the SheetInfo of every sheet
+func inspectWorkbook(f *excelize.File) ([]SheetInfo, error) {
+	var sheets []SheetInfo
+	for _, name := range f.GetSheetList() {
+		visible, err := f.GetSheetVisible(name)
+		if err != nil {
+			return nil, err
+		}
+
+		dimension, err := f.GetSheetDimension(name)
+		if err != nil {
+			return nil, err
+		}
+
+		rows, cols, err := measureSheet(f, name)
+		if err != nil {
+			return nil, err
+		}
+
+		sheets = append(sheets, SheetInfo{
+			Name:      name,
+			Visible:   visible,
+			Dimension: dimension,
+			Rows:      rows,
+			Cols:      cols,
+		})
+	}
+	return sheets, nil
+}
+
+// measureSheet counts the rows and columns of the sheet with the row iterator
+func measureSheet(f *excelize.File, sheet string) (rows, cols int, err error) {
+	iter, err := f.Rows(sheet)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer iter.Close()
+
+	for iter.Next() {
+		rows++
+		cells, err := iter.Columns(excelize.Options{RawCellValue: true})
+		if err != nil {
+			return 0, 0, err
+		}
+		cols = max(cols, len(cells))
+	}
+	return rows, cols, iter.Error()
+}
@@ -0,0 +1,36 @@
+package xlsx_utilities
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInspect(t *testing.T) {
+	filename := "test_inspect.xlsx"
+	defer os.Remove(filename)
+
+	excelData, err := FromStruct([]order{{ID: 1, Status: "paid", Total: 10}, {ID: 2, Status: "open", Total: 20}})
+	assert.NoError(t, err)
+
+	f, err := excelData.ToWorkbook(WithSheet("Orders"))
+	assert.NoError(t, err)
+	assert.NoError(t, excelData.AddToWorkbook(f, WithSheet("Lookup"), WithAnchor("B3"), WithSheetVisibility(SheetHidden)))
+	assert.NoError(t, f.SaveAs(filename))
+	f.Close()
+
+	sheets, err := Inspect(filename)
+	assert.NoError(t, err)
+	assert.Equal(t, []SheetInfo{
+		{Name: "Orders", Visible: true, Dimension: "A1", Rows: 3, Cols: 3},
+		{Name: "Lookup", Visible: false, Dimension: "A1", Rows: 5, Cols: 4},
+	}, sheets)
+
+	t.Run("Upload limits", func(t *testing.T) {
+		_, err := Inspect(filename, WithMaxFileSize(100))
+		assert.ErrorIs(t, err, ErrLimitExceeded)
+		_, err = Inspect(filename, WithMaxUncompressedSize(100))
+		assert.ErrorIs(t, err, ErrLimitExceeded)
+	})
+}
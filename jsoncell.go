@@ -0,0 +1,48 @@
+package xlsx_utilities
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+)
+
+// rawMessageType is the type of json.RawMessage fields, which are always JSON columns
+var rawMessageType = reflect.TypeOf(json.RawMessage(nil))
+
+// isJSONField reports whether the field is written to a single cell as JSON text: json.RawMessage
+// fields and fields tagged with the json option, e.g. `xlsx:"Attributes,json"`
+func isJSONField(field reflect.StructField) bool {
+	return field.Type == rawMessageType || hasTagOption(field, "json")
+}
+
+// jsonCell returns the JSON text of a field value, or nil for nil pointers, maps, slices and empty raw messages
+func jsonCell(v reflect.Value) (interface{}, error) {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Map, reflect.Slice, reflect.Interface:
+		if v.IsNil() || v.Type() == rawMessageType && v.Len() == 0 {
+			return nil, nil
+		}
+	}
+
+	data, err := json.Marshal(v.Interface())
+	if err != nil {
+		return nil, fmt.Errorf("error encoding JSON: %w", err)
+	}
+	return string(data), nil
+}
+
+// setJSONField unmarshals the JSON text of a cell into the field; empty cells leave it unchanged
+func setJSONField(field reflect.Value, value interface{}) error {
+	if isEmptyCell(value) {
+		return nil
+	}
+
+	text, ok := value.(string)
+	if !ok {
+		text = fmt.Sprintf("%v", value)
+	}
+	if err := json.Unmarshal([]byte(text), field.Addr().Interface()); err != nil {
+		return &ConversionError{Value: value, Type: field.Type(), Err: err}
+	}
+	return nil
+}
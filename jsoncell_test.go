@@ -0,0 +1,50 @@
+package xlsx_utilities
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJSONColumns(t *testing.T) {
+	type dimensions struct {
+		Width  int `json:"w"`
+		Height int `json:"h"`
+	}
+	type product struct {
+		SKU   string
+		Attrs map[string]string `xlsx:"Attributes,json"`
+		Size  dimensions        `xlsx:",json"`
+		Tags  []string          `xlsx:",json"`
+		Extra json.RawMessage
+	}
+
+	data := []*product{
+		{SKU: "A1", Attrs: map[string]string{"color": "red"}, Size: dimensions{2, 3}, Tags: []string{"new"}, Extra: json.RawMessage(`{"x":1}`)},
+		{SKU: "B2"},
+	}
+
+	excelData, err := FromStruct(data, WithStrictRoundTrip())
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"SKU", "Attributes", "Size", "Tags", "Extra"}, excelData.Headers)
+	assert.Equal(t, []interface{}{"A1", `{"color":"red"}`, `{"w":2,"h":3}`, `["new"]`, `{"x":1}`}, excelData.Rows[0])
+	assert.Equal(t, []interface{}{"B2", nil, `{"w":0,"h":0}`, nil, nil}, excelData.Rows[1])
+
+	result := excelData.ToStruct()
+	assert.Empty(t, result.Errors)
+	assert.Equal(t, data[0], result.Data[0])
+	assert.Equal(t, &product{SKU: "B2"}, result.Data[1])
+
+	t.Run("Invalid JSON", func(t *testing.T) {
+		invalid := NewExcelData[*product]([]string{"SKU", "Attributes"})
+		invalid.AddRow([]interface{}{"C3", "{color"})
+
+		result := invalid.ToStruct()
+		assert.Len(t, result.Errors, 1)
+		var convErr *ConversionError
+		assert.True(t, errors.As(result.Errors[0], &convErr))
+		assert.Equal(t, "Attributes", result.Errors[0].Header)
+	})
+}
@@ -0,0 +1,105 @@
+package xlsx_utilities
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// sheetLayout describes where the exported block of data lives on a sheet
+type sheetLayout struct {
+	Sheet     string
+	Col       int // 1-based column of the top-left cell
+	Row       int // 1-based row of the top-left cell (the header row when present)
+	HeaderRow bool
+	Cols      int // number of columns
+	Rows      int // number of data rows
+	// Transposed places the headers down the first column and each row in the next columns.
+	// Rows and columns keep their logical meaning; cell maps them to the sheet.
+	Transposed bool
+	// GroupRow is set when the row above Row holds the group headers of WithGroupedHeaders
+	GroupRow bool
+}
+
+// exportLayout returns the layout of an export of cols columns and rows data rows at the configured anchor cell,
+// before any group header row is added
+func exportLayout(cfg *config, cols, rows int) (sheetLayout, error) {
+	col, row, err := excelize.CellNameToCoordinates(cfg.anchor)
+	if err != nil {
+		return sheetLayout{}, fmt.Errorf("invalid anchor cell '%s': %w", cfg.anchor, err)
+	}
+
+	return sheetLayout{
+		Sheet:      cfg.sheet,
+		Col:        col,
+		Row:        row,
+		HeaderRow:  !cfg.skipHeaders,
+		Cols:       cols,
+		Rows:       rows,
+		Transposed: cfg.transposed,
+	}, nil
+}
+
+// belowGroupRow returns the layout of the block below a group header row written on the first row of l
+func (l sheetLayout) belowGroupRow() sheetLayout {
+	l.Row++
+	l.GroupRow = true
+	return l
+}
+
+// firstDataRow returns the 1-based row of the first data row
+func (l sheetLayout) firstDataRow() int {
+	if l.HeaderRow {
+		return l.Row + 1
+	}
+	return l.Row
+}
+
+// lastRow returns the 1-based row of the last data row (or the header row when there is no data)
+func (l sheetLayout) lastRow() int {
+	return l.firstDataRow() + l.Rows - 1
+}
+
+// cell returns the cell name of the 0-based column offset within the block on the given 1-based row
+func (l sheetLayout) cell(col, row int) string {
+	if l.Transposed {
+		return fmt.Sprintf("%s%d", intToExcelColumn(l.Col-1+row-l.Row), l.Row+col)
+	}
+	return fmt.Sprintf("%s%d", intToExcelColumn(l.Col-1+col), row)
+}
+
+// rangeRef returns the reference covering the whole block, e.g. "A1:C10"
+func (l sheetLayout) rangeRef() string {
+	cols := l.Cols
+	if cols == 0 {
+		cols = 1
+	}
+	last := l.lastRow()
+	if last < l.Row {
+		last = l.Row
+	}
+	return fmt.Sprintf("%s:%s", l.cell(0, l.Row), l.cell(cols-1, last))
+}
+
+// columnRef returns the reference covering the data cells of the 0-based column offset, e.g. "B2:B10"
+func (l sheetLayout) columnRef(col int) string {
+	return fmt.Sprintf("%s:%s", l.cell(col, l.firstDataRow()), l.cell(col, l.lastRow()))
+}
+
+// absoluteRange returns a sheet-qualified absolute reference for the given 1-based coordinates
+func absoluteRange(sheet string, col1, row1, col2, row2 int) string {
+	return fmt.Sprintf("%s!$%s$%d:$%s$%d", sheet,
+		intToExcelColumn(col1-1), row1, intToExcelColumn(col2-1), row2)
+}
+
+// quoteSheetName quotes a sheet name for use in formulas and chart references
+// when it contains characters other than letters, digits and underscores
+func quoteSheetName(sheet string) string {
+	for _, r := range sheet {
+		if !(r == '_' || r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9') {
+			return "'" + strings.ReplaceAll(sheet, "'", "''") + "'"
+		}
+	}
+	return sheet
+}
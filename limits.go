@@ -0,0 +1,139 @@
+package xlsx_utilities
+
+import (
+	"archive/zip"
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// uploadLimits bounds the workbooks an import accepts; zero values are unlimited
+type uploadLimits struct {
+	fileSize         int64
+	uncompressedSize int64
+	rows             int
+	cols             int
+}
+
+// WithMaxFileSize rejects workbooks larger than the given number of bytes before they are parsed
+func WithMaxFileSize(bytes int64) Option {
+	return func(c *config) {
+		c.limits.fileSize = bytes
+	}
+}
+
+// WithMaxUncompressedSize rejects workbooks whose parts add up to more than the given number of bytes
+// once unzipped, so a small upload cannot expand into gigabytes of XML (a zip bomb)
+func WithMaxUncompressedSize(bytes int64) Option {
+	return func(c *config) {
+		c.limits.uncompressedSize = bytes
+	}
+}
+
+// WithMaxRows rejects sheets with more than n data rows below the header. The sheet is
+// streamed and the import stops at the first row past the limit, before any cell is converted.
+func WithMaxRows(n int) Option {
+	return func(c *config) {
+		c.limits.rows = n
+	}
+}
+
+// WithMaxColumns rejects sheets with a row wider than n columns
+func WithMaxColumns(n int) Option {
+	return func(c *config) {
+		c.limits.cols = n
+	}
+}
+
+// openOptions returns the excelize options enforcing the limits while the workbook is unzipped
+func (l uploadLimits) openOptions() []excelize.Options {
+	if l.uncompressedSize <= 0 {
+		return nil
+	}
+	return []excelize.Options{{UnzipSizeLimit: l.uncompressedSize}}
+}
+
+// checkArchive checks the size of the file and the declared size of its unzipped parts
+func (l uploadLimits) checkArchive(r io.ReaderAt, size int64) error {
+	if l.fileSize > 0 && size > l.fileSize {
+		return fmt.Errorf("%w: file is %d bytes, the limit is %d", ErrLimitExceeded, size, l.fileSize)
+	}
+	if l.uncompressedSize <= 0 {
+		return nil
+	}
+
+	z, err := zip.NewReader(r, size)
+	if err != nil {
+		// not a zip archive, e.g. an encrypted workbook; excelize reports it when opening
+		return nil
+	}
+	var total uint64
+	for _, file := range z.File {
+		total += file.UncompressedSize64
+		if total > uint64(l.uncompressedSize) {
+			return fmt.Errorf("%w: file unzips to more than %d bytes", ErrLimitExceeded, l.uncompressedSize)
+		}
+	}
+	return nil
+}
+
+// checkSheet streams the rows of the sheet and fails at the first one past the row or column limit
+func (l uploadLimits) checkSheet(f *excelize.File, cfg *config) error {
+	if l.rows <= 0 && l.cols <= 0 {
+		return nil
+	}
+
+	iter, err := f.Rows(cfg.sheet)
+	if err != nil {
+		return err
+	}
+	defer iter.Close()
+
+	for rows := 0; iter.Next(); rows++ {
+		if l.rows > 0 && rows-cfg.headerRows() >= l.rows {
+			return fmt.Errorf("%w: sheet '%s' has more than %d data rows", ErrLimitExceeded, cfg.sheet, l.rows)
+		}
+		if l.cols > 0 {
+			cells, err := iter.Columns(excelize.Options{RawCellValue: true})
+			if err != nil {
+				return err
+			}
+			if len(cells) > l.cols {
+				return fmt.Errorf("%w: sheet '%s' has more than %d columns", ErrLimitExceeded, cfg.sheet, l.cols)
+			}
+		}
+	}
+	return iter.Error()
+}
+
+// openExcelFile opens a workbook file within the configured limits
+func openExcelFile(filename string, cfg *config) (*excelize.File, error) {
+	if cfg.limits.fileSize > 0 || cfg.limits.uncompressedSize > 0 {
+		file, err := os.Open(filename)
+		if err != nil {
+			return nil, err
+		}
+		defer file.Close()
+
+		info, err := file.Stat()
+		if err != nil {
+			return nil, err
+		}
+		if err := cfg.limits.checkArchive(file, info.Size()); err != nil {
+			return nil, err
+		}
+	}
+
+	return excelize.OpenFile(filename, cfg.limits.openOptions()...)
+}
+
+// openExcelReader opens a workbook held in memory within the configured limits
+func openExcelReader(r *bytes.Reader, cfg *config) (*excelize.File, error) {
+	if err := cfg.limits.checkArchive(r, r.Size()); err != nil {
+		return nil, err
+	}
+	return excelize.OpenReader(r, cfg.limits.openOptions()...)
+}
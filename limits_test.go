@@ -0,0 +1,47 @@
+package xlsx_utilities
+
+import (
+	"bytes"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUploadLimits(t *testing.T) {
+	filename := "test_limits.xlsx"
+	defer os.Remove(filename)
+
+	excelData, err := FromStruct([]person{{Name: "Alice", Age: 30}, {Name: "Bob", Age: 25}, {Name: "Carol", Age: 41}})
+	assert.NoError(t, err)
+	assert.NoError(t, excelData.Save(filename))
+	content, err := os.ReadFile(filename)
+	assert.NoError(t, err)
+
+	t.Run("Within limits", func(t *testing.T) {
+		imported, err := FromExcel[person](filename, WithMaxFileSize(1<<20), WithMaxUncompressedSize(1<<20),
+			WithMaxRows(3), WithMaxColumns(2))
+		assert.NoError(t, err)
+		assert.Len(t, imported.Rows, 3)
+	})
+
+	t.Run("File size", func(t *testing.T) {
+		_, err := FromFileExcel[person](bytes.NewReader(content), WithMaxFileSize(100))
+		assert.ErrorIs(t, err, ErrLimitExceeded)
+		assert.ErrorContains(t, err, "the limit is 100")
+	})
+
+	t.Run("Uncompressed size", func(t *testing.T) {
+		_, err := FromExcel[person](filename, WithMaxUncompressedSize(int64(len(content))))
+		assert.ErrorIs(t, err, ErrLimitExceeded)
+	})
+
+	t.Run("Rows and columns", func(t *testing.T) {
+		_, err := FromExcel[person](filename, WithMaxRows(2))
+		assert.ErrorIs(t, err, ErrLimitExceeded)
+		assert.ErrorContains(t, err, "more than 2 data rows")
+
+		_, err = FromExcelAllSheets[person](filename, WithMaxColumns(1))
+		assert.ErrorIs(t, err, ErrLimitExceeded)
+	})
+}
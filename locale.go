@@ -0,0 +1,112 @@
+package xlsx_utilities
+
+import (
+	"time"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// excelizeDateNumFmt is the built-in number format excelize gives to unstyled time.Time cells
+const excelizeDateNumFmt = 22
+
+// Locale describes locale-specific rendering of exported values. Empty fields keep the Excel defaults.
+// Formats accept locale prefixes, e.g. "[$-ar-SA]dd/mm/yyyy" or "[$-he-IL]#,##0.00".
+type Locale struct {
+	DateFormat   string // number format of time.Time cells
+	NumberFormat string // number format of integer and float cells
+	True         string // text written for true, e.g. "صحيح"
+	False        string // text written for false, e.g. "خطأ"
+}
+
+// WithRightToLeft displays the sheet right-to-left, with column A on the right
+func WithRightToLeft() Option {
+	return func(c *config) {
+		c.rightToLeft = true
+	}
+}
+
+// WithLocale renders exported dates, numbers and booleans for the given locale.
+// On import, bool fields read the localized True and False texts, as with WithBoolSynonyms.
+func WithLocale(locale Locale) Option {
+	return func(c *config) {
+		c.locale = &locale
+
+		synonyms := make(map[string]bool)
+		if locale.True != "" {
+			synonyms[locale.True] = true
+		}
+		if locale.False != "" {
+			synonyms[locale.False] = false
+		}
+		WithBoolSynonyms(synonyms)(c)
+	}
+}
+
+// localizeValue returns the text of a boolean value in the configured locale
+func localizeValue(value interface{}, cfg *config) interface{} {
+	if cfg.locale == nil {
+		return value
+	}
+	if b, ok := value.(bool); ok {
+		if b && cfg.locale.True != "" {
+			return cfg.locale.True
+		}
+		if !b && cfg.locale.False != "" {
+			return cfg.locale.False
+		}
+	}
+	return value
+}
+
+// applySheetDirection sets the sheet direction when right-to-left is configured
+func applySheetDirection(f *excelize.File, layout sheetLayout, cfg *config) error {
+	if !cfg.rightToLeft {
+		return nil
+	}
+
+	rightToLeft := true
+	return f.SetSheetView(layout.Sheet, -1, &excelize.ViewOptions{RightToLeft: &rightToLeft})
+}
+
+// applyLocaleFormats sets the locale number formats on date and number data cells
+// that do not already have a number format
+func applyLocaleFormats(f *excelize.File, layout sheetLayout, cfg *config, rows [][]interface{}) error {
+	if cfg.locale == nil || cfg.locale.DateFormat == "" && cfg.locale.NumberFormat == "" {
+		return nil
+	}
+
+	dates := newRestyler(f, layout.Sheet, func(style *excelize.Style) {
+		if (style.NumFmt == 0 || style.NumFmt == excelizeDateNumFmt) && style.CustomNumFmt == nil {
+			style.CustomNumFmt = &cfg.locale.DateFormat
+		}
+	})
+	numbers := newRestyler(f, layout.Sheet, func(style *excelize.Style) {
+		if style.NumFmt == 0 && style.CustomNumFmt == nil {
+			style.CustomNumFmt = &cfg.locale.NumberFormat
+		}
+	})
+
+	for rowIndex, row := range rows {
+		for i, value := range row {
+			var r *restyler
+			switch value.(type) {
+			case time.Time:
+				if cfg.locale.DateFormat != "" {
+					r = dates
+				}
+			case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64:
+				if cfg.locale.NumberFormat != "" {
+					r = numbers
+				}
+			}
+			if r == nil {
+				continue
+			}
+			if err := r.restyle(layout.cell(i, layout.firstDataRow()+rowIndex)); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
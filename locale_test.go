@@ -0,0 +1,68 @@
+package xlsx_utilities
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLocale(t *testing.T) {
+	hebrew := Locale{
+		DateFormat:   "[$-he-IL]dd/mm/yyyy",
+		NumberFormat: "#,##0.00",
+		True:         "כן",
+		False:        "לא",
+	}
+
+	excelData := NewExcelData[person]([]string{"Name", "Joined", "Salary", "Active"})
+	excelData.AddRow([]interface{}{"Alice", time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), 1234.5, true})
+	excelData.AddRow([]interface{}{"Bob", time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC), 99, false})
+
+	t.Run("Export", func(t *testing.T) {
+		f, err := excelData.ToWorkbook(WithRightToLeft(), WithLocale(hebrew))
+		assert.NoError(t, err)
+		defer f.Close()
+
+		view, err := f.GetSheetView("Sheet1", -1)
+		assert.NoError(t, err)
+		assert.True(t, *view.RightToLeft)
+
+		for cell, format := range map[string]string{"B2": hebrew.DateFormat, "C2": hebrew.NumberFormat, "C3": hebrew.NumberFormat} {
+			id, err := f.GetCellStyle("Sheet1", cell)
+			assert.NoError(t, err)
+			style, err := f.GetStyle(id)
+			assert.NoError(t, err)
+			if assert.NotNil(t, style.CustomNumFmt, cell) {
+				assert.Equal(t, format, *style.CustomNumFmt, cell)
+			}
+		}
+
+		value, _ := f.GetCellValue("Sheet1", "D2")
+		assert.Equal(t, "כן", value)
+		value, _ = f.GetCellValue("Sheet1", "D3")
+		assert.Equal(t, "לא", value)
+	})
+
+	t.Run("Import localized booleans", func(t *testing.T) {
+		filename := "test_locale.xlsx"
+		defer os.Remove(filename)
+		type profile struct {
+			Name   string
+			Active bool
+			Answer string
+		}
+		answers := NewExcelData[profile]([]string{"Name", "Active", "Answer"})
+		answers.AddRow([]interface{}{"Alice", true, "כן"})
+		answers.AddRow([]interface{}{"Bob", false, "לא"})
+		assert.NoError(t, answers.Save(filename, WithLocale(hebrew)))
+
+		imported, err := FromExcel[profile](filename, WithLocale(hebrew))
+		assert.NoError(t, err)
+		result := imported.ToStruct()
+		assert.Empty(t, result.Errors)
+		// only bool fields read the localized texts
+		assert.Equal(t, []profile{{"Alice", true, "כן"}, {"Bob", false, "לא"}}, result.Data)
+	})
+}
@@ -0,0 +1,38 @@
+package xlsx_utilities
+
+import "strings"
+
+// WithLookup replaces the cells of a column by their entry in a lookup table before conversion,
+// e.g. country names by ISO codes. Keys are matched on the trimmed cell text, case-insensitively
+// when there is no exact match; cells without an entry are converted as they are.
+// It can be given once per column; the column is named by the header in the sheet or of the field.
+func WithLookup(column string, table map[string]interface{}) Option {
+	return func(c *config) {
+		if c.lookups == nil {
+			c.lookups = make(map[string]map[string]interface{})
+		}
+		c.lookups[column] = table
+	}
+}
+
+// lookupFor returns the lookup table of a column by its header in the sheet or of the field
+func (c *config) lookupFor(header, field string) map[string]interface{} {
+	if table, ok := c.lookups[header]; ok {
+		return table
+	}
+	return c.lookups[field]
+}
+
+// lookupValue returns the entry of the cell in the table, or the cell when it has none
+func lookupValue(table map[string]interface{}, cell interface{}) interface{} {
+	key := strings.TrimSpace(cellText(cell))
+	if value, ok := table[key]; ok {
+		return value
+	}
+	for k, value := range table {
+		if strings.EqualFold(k, key) {
+			return value
+		}
+	}
+	return cell
+}
@@ -0,0 +1,34 @@
+package xlsx_utilities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLookup(t *testing.T) {
+	type customer struct {
+		Name    string
+		Country string `xlsx:"Country Code"`
+		Tier    int
+	}
+
+	excelData := NewExcelData[customer]([]string{"Name", "Country", "Tier"})
+	excelData.AddRow([]interface{}{"Alice", "Germany", "gold"})
+	excelData.AddRow([]interface{}{"Bob", " france ", "silver"})
+	excelData.AddRow([]interface{}{"Carol", "ES", "3"})
+
+	result := excelData.WithOptions(
+		WithHeaderTranslations(map[string]string{"Country Code": "Country"}),
+		WithLookup("Country", map[string]interface{}{"Germany": "DE", "France": "FR"}),
+		WithLookup("Tier", map[string]interface{}{"gold": 1, "silver": 2}),
+	).ToStruct()
+
+	assert.Empty(t, result.Errors)
+	assert.Equal(t, []customer{
+		{Name: "Alice", Country: "DE", Tier: 1},
+		{Name: "Bob", Country: "FR", Tier: 2},
+		{Name: "Carol", Country: "ES", Tier: 3},
+	}, result.Data)
+	assert.Equal(t, "Germany", excelData.Rows[0][1])
+}
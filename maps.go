@@ -0,0 +1,51 @@
+package xlsx_utilities
+
+import "sort"
+
+// FromMaps converts schemaless records, e.g. decoded JSON payloads or database documents, to ExcelData.
+// The headers are the keys of all records in sorted order; use SelectColumns to reorder them.
+// Records without a key get an empty cell. Use struct{} for T when the data is not converted to a struct.
+func FromMaps[T comparable](rows []map[string]interface{}, opts ...Option) (*ExcelData[T], error) {
+	if len(rows) == 0 {
+		return nil, ErrEmptyInput
+	}
+
+	seen := make(map[string]bool)
+	var headers []string
+	for _, row := range rows {
+		for key := range row {
+			if !seen[key] {
+				seen[key] = true
+				headers = append(headers, key)
+			}
+		}
+	}
+	sort.Strings(headers)
+
+	ed := NewExcelData[T](headers)
+	ed.options = opts
+	for _, row := range rows {
+		values := make([]interface{}, len(headers))
+		for i, header := range headers {
+			values[i] = row[header]
+		}
+		ed.Rows = append(ed.Rows, values)
+	}
+	return ed, nil
+}
+
+// ToMaps returns each row as a map from header to cell value. Missing trailing cells are nil,
+// and of repeated headers the first column wins, as with Row.Get.
+func (ed *ExcelData[T]) ToMaps() []map[string]interface{} {
+	maps := make([]map[string]interface{}, len(ed.Rows))
+	for rowIndex, row := range ed.Rows {
+		m := make(map[string]interface{}, len(ed.Headers))
+		for i, header := range ed.Headers {
+			if _, ok := m[header]; !ok {
+				m[header] = cellAt(row, i)
+			}
+		}
+		maps[rowIndex] = m
+	}
+	return maps
+}
@@ -0,0 +1,54 @@
+package xlsx_utilities
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMaps(t *testing.T) {
+	rows := []map[string]interface{}{
+		{"Name": "Alice", "Age": 30},
+		{"Name": "Bob", "City": "Rome"},
+	}
+
+	t.Run("FromMaps", func(t *testing.T) {
+		excelData, err := FromMaps[person](rows)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"Age", "City", "Name"}, excelData.Headers)
+		assert.Equal(t, [][]interface{}{{30, nil, "Alice"}, {nil, "Rome", "Bob"}}, excelData.Rows)
+
+		_, err = FromMaps[struct{}](nil)
+		assert.EqualError(t, err, "input slice is empty")
+	})
+
+	t.Run("Round trip through a file", func(t *testing.T) {
+		filename := "test_maps.xlsx"
+		defer os.Remove(filename)
+
+		excelData, err := FromMaps[struct{}](rows)
+		assert.NoError(t, err)
+		assert.NoError(t, excelData.Save(filename))
+
+		read, err := FromExcel[struct{}](filename)
+		assert.NoError(t, err)
+		assert.Equal(t, []map[string]interface{}{
+			{"Age": 30, "City": "", "Name": "Alice"},
+			{"Age": "", "City": "Rome", "Name": "Bob"},
+		}, read.ToMaps())
+	})
+
+	t.Run("Short rows", func(t *testing.T) {
+		excelData := NewExcelData[struct{}]([]string{"A", "B"})
+		excelData.Rows = append(excelData.Rows, []interface{}{1})
+		assert.Equal(t, []map[string]interface{}{{"A": 1, "B": nil}}, excelData.ToMaps())
+	})
+
+	t.Run("Repeated headers", func(t *testing.T) {
+		excelData := NewExcelData[struct{}]([]string{"A", "A"})
+		excelData.AddRow([]interface{}{1, 2})
+		assert.Equal(t, []map[string]interface{}{{"A": 1}}, excelData.ToMaps())
+		assert.Equal(t, 1, excelData.newRows()(0).Get("A"))
+	})
+}
@@ -0,0 +1,131 @@
+package xlsx_utilities
+
+import (
+	"encoding"
+	"reflect"
+	"time"
+)
+
+// XLSXMarshaler is implemented by types that control their own cell representation on export
+type XLSXMarshaler interface {
+	MarshalXLSX() (string, error)
+}
+
+// XLSXUnmarshaler is implemented by types that parse their own cell representation on import.
+// UnmarshalXLSX is called with the cell text, including empty cells.
+type XLSXUnmarshaler interface {
+	UnmarshalXLSX(string) error
+}
+
+var (
+	xlsxMarshalerType   = reflect.TypeOf((*XLSXMarshaler)(nil)).Elem()
+	xlsxUnmarshalerType = reflect.TypeOf((*XLSXUnmarshaler)(nil)).Elem()
+	textMarshalerType   = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+)
+
+// cellConverter converts a field value into the value written to its cell
+type cellConverter func(interface{}) (interface{}, error)
+
+// converterFor returns the converter of t: the registered one, the built-in one for sql.Null* types,
+// or one based on XLSXMarshaler, driver.Valuer and then encoding.TextMarshaler. Pointer types are resolved
+// through their element type.
+func converterFor(t reflect.Type) (cellConverter, bool) {
+	if converter, ok := TypeConverters[t]; ok {
+		return stringConverter(converter), true
+	}
+	if t.Kind() == reflect.Ptr {
+		return nil, false
+	}
+	if nullTypes[t] {
+		return nullConverter, true
+	}
+
+	switch {
+	case implements(t, xlsxMarshalerType):
+		return stringConverter(func(i interface{}) (string, error) {
+			return addressable(t, i).Interface().(XLSXMarshaler).MarshalXLSX()
+		}), true
+	case implements(t, valuerType):
+		return valuerConverter(t), true
+	case implements(t, textMarshalerType):
+		return stringConverter(textConverter(t)), true
+	}
+	return nil, false
+}
+
+// stringConverter adapts a converter producing text to a cellConverter
+func stringConverter(converter CustomTypeConverter) cellConverter {
+	return func(i interface{}) (interface{}, error) {
+		return converter(i)
+	}
+}
+
+// parserFor returns the parser of t: the registered one, the built-in one for sql.Null* types,
+// or one based on XLSXUnmarshaler, sql.Scanner and then encoding.TextUnmarshaler. Pointer types are resolved
+// through their element type.
+func parserFor(t reflect.Type) (CustomTypeParser, bool) {
+	if parser, ok := TypeParsers[t]; ok {
+		return parser, true
+	}
+	// time.Time is parsed by setField, which falls back to common date layouts
+	if t.Kind() == reflect.Ptr || t == reflect.TypeOf(time.Time{}) {
+		return nil, false
+	}
+	if nullTypes[t] {
+		return nullParser(t), true
+	}
+
+	switch {
+	case reflect.PointerTo(t).Implements(xlsxUnmarshalerType):
+		return func(s string) (interface{}, error) {
+			v := reflect.New(t)
+			if err := v.Interface().(XLSXUnmarshaler).UnmarshalXLSX(s); err != nil {
+				return nil, err
+			}
+			return v.Elem().Interface(), nil
+		}, true
+	case reflect.PointerTo(t).Implements(scannerType):
+		return scannerParser(t), true
+	case reflect.PointerTo(t).Implements(textUnmarshalerType):
+		return textParser(t), true
+	}
+	return nil, false
+}
+
+// implements reports whether values of t, or pointers to them, implement the interface
+func implements(t, iface reflect.Type) bool {
+	return t.Implements(iface) || reflect.PointerTo(t).Implements(iface)
+}
+
+// addressable returns a pointer to a copy of i so methods with pointer receivers can be called
+func addressable(t reflect.Type, i interface{}) reflect.Value {
+	v := reflect.New(t)
+	v.Elem().Set(reflect.ValueOf(i))
+	return v
+}
+
+// textConverter converts values of t using encoding.TextMarshaler
+func textConverter(t reflect.Type) CustomTypeConverter {
+	return func(i interface{}) (string, error) {
+		text, err := addressable(t, i).Interface().(encoding.TextMarshaler).MarshalText()
+		if err != nil {
+			return "", err
+		}
+		return string(text), nil
+	}
+}
+
+// textParser parses values of t using encoding.TextUnmarshaler; empty cells leave the zero value
+func textParser(t reflect.Type) CustomTypeParser {
+	return func(s string) (interface{}, error) {
+		if s == "" {
+			return nil, nil
+		}
+		v := reflect.New(t)
+		if err := v.Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(s)); err != nil {
+			return nil, err
+		}
+		return v.Elem().Interface(), nil
+	}
+}
@@ -0,0 +1,74 @@
+package xlsx_utilities
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// money controls its own cell representation
+type money struct {
+	Cents    int64
+	Currency string
+}
+
+func (m money) MarshalXLSX() (string, error) {
+	return fmt.Sprintf("%d.%02d %s", m.Cents/100, m.Cents%100, m.Currency), nil
+}
+
+func (m *money) UnmarshalXLSX(s string) error {
+	var units, cents int64
+	if _, err := fmt.Sscanf(s, "%d.%d %s", &units, &cents, &m.Currency); err != nil {
+		return err
+	}
+	m.Cents = units*100 + cents
+	return nil
+}
+
+// grade falls back to encoding.TextMarshaler and encoding.TextUnmarshaler
+type grade struct {
+	Letter string
+}
+
+func (g grade) MarshalText() ([]byte, error) {
+	return []byte("grade:" + g.Letter), nil
+}
+
+func (g *grade) UnmarshalText(text []byte) error {
+	letter, ok := strings.CutPrefix(string(text), "grade:")
+	if !ok {
+		return fmt.Errorf("invalid grade: %s", text)
+	}
+	g.Letter = letter
+	return nil
+}
+
+func TestMarshalerInterfaces(t *testing.T) {
+	type invoice struct {
+		Number string
+		Total  money
+		Grade  *grade
+	}
+
+	filename := "test_marshal.xlsx"
+	defer os.Remove(filename)
+
+	data := []invoice{{Number: "INV-1", Total: money{Cents: 1250, Currency: "USD"}, Grade: &grade{Letter: "A"}}}
+
+	excelData, err := FromStruct(data)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Number", "Total", "Grade"}, excelData.Headers)
+	assert.Equal(t, []interface{}{"INV-1", "12.50 USD", "grade:A"}, excelData.Rows[0])
+
+	assert.NoError(t, excelData.Save(filename))
+
+	imported, err := FromExcel[invoice](filename)
+	assert.NoError(t, err)
+
+	result := imported.ToStruct()
+	assert.Empty(t, result.Errors)
+	assert.Equal(t, data, result.Data)
+}
@@ -0,0 +1,64 @@
+package xlsx_utilities
+
+import (
+	"fmt"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReleaseRows(t *testing.T) {
+	for _, parallelism := range []int{0, 3} {
+		excelData := NewExcelData[person]([]string{"Name", "Age"})
+		excelData.AddRow([]interface{}{"Alice", 30})
+		excelData.AddRow([]interface{}{"Bob", 25})
+
+		result := excelData.WithOptions(WithReleaseRows(), WithParallelism(parallelism)).ToStruct()
+		assert.Equal(t, []person{{"Alice", 30}, {"Bob", 25}}, result.Data)
+		assert.Nil(t, excelData.Rows)
+	}
+}
+
+// benchmarkRows returns a dataset of n people
+func benchmarkRows(n int) *ExcelData[person] {
+	excelData := NewExcelData[person]([]string{"Name", "Age"})
+	excelData.Rows = make([][]interface{}, n)
+	for i := range excelData.Rows {
+		excelData.Rows[i] = []interface{}{fmt.Sprintf("Person %d", i), fmt.Sprint(i % 100)}
+	}
+	return excelData
+}
+
+// BenchmarkToStruct reports the heap still in use after the conversion (live-MB), which
+// drops with WithReleaseRows since the rows can be collected while the records are kept.
+func BenchmarkToStruct(b *testing.B) {
+	for _, n := range []int{10_000, 1_000_000} {
+		for _, release := range []bool{false, true} {
+			b.Run(fmt.Sprintf("rows=%d/release=%t", n, release), func(b *testing.B) {
+				b.ReportAllocs()
+				var live uint64
+				for i := 0; i < b.N; i++ {
+					b.StopTimer()
+					excelData := benchmarkRows(n)
+					if release {
+						excelData.WithOptions(WithReleaseRows())
+					}
+					b.StartTimer()
+
+					result := excelData.ToStruct()
+
+					b.StopTimer()
+					var stats runtime.MemStats
+					runtime.GC()
+					runtime.ReadMemStats(&stats)
+					live += stats.HeapAlloc
+					runtime.KeepAlive(result)
+					runtime.KeepAlive(excelData)
+					b.StartTimer()
+				}
+				b.ReportMetric(float64(live)/float64(b.N)/(1<<20), "live-MB")
+			})
+		}
+	}
+}
@@ -0,0 +1,128 @@
+package xlsx_utilities
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// MergeSpec describes a block of cells merged into one on export.
+// Either set Range to an explicit reference on the sheet (e.g. "A1:D1"),
+// or set Header (and optionally ToHeader) with a data row range.
+type MergeSpec struct {
+	Range    string // explicit reference, e.g. "A1:D1"; takes precedence over the header fields
+	Header   string // header of the first merged column
+	ToHeader string // header of the last merged column; defaults to Header
+	FromRow  int    // 0-based index of the first merged data row
+	ToRow    int    // 0-based index of the last merged data row (inclusive)
+}
+
+// WithMergeCells merges cells of the export. It can be given several times.
+func WithMergeCells(spec MergeSpec) Option {
+	return func(c *config) {
+		c.merges = append(c.merges, spec)
+	}
+}
+
+// MergeCells merges cells in every later export of the ExcelData
+func (ed *ExcelData[T]) MergeCells(spec MergeSpec) *ExcelData[T] {
+	return ed.WithOptions(WithMergeCells(spec))
+}
+
+// WithFillMergedCells copies the value of merged cells into every cell they cover on import, so a
+// category merged across several rows is read in each of them instead of only the top-left one
+func WithFillMergedCells() Option {
+	return func(c *config) {
+		c.fillMergedCells = true
+	}
+}
+
+// fillMergedCells copies the text of the top-left cell of each merged block of the sheet into the other
+// cells of the block, widening rows as needed. Blocks below the rows read are ignored.
+func fillMergedCells(f *excelize.File, sheet string, rows [][]string) error {
+	merged, err := f.GetMergeCells(sheet)
+	if err != nil {
+		return err
+	}
+
+	for _, block := range merged {
+		startCol, startRow, err := excelize.CellNameToCoordinates(block.GetStartAxis())
+		if err != nil {
+			return err
+		}
+		endCol, endRow, err := excelize.CellNameToCoordinates(block.GetEndAxis())
+		if err != nil {
+			return err
+		}
+		if startRow > len(rows) {
+			continue
+		}
+
+		value := textAt(rows[startRow-1], startCol-1)
+		for r := startRow - 1; r < min(endRow, len(rows)); r++ {
+			for len(rows[r]) < endCol {
+				rows[r] = append(rows[r], "")
+			}
+			for c := startCol - 1; c < endCol; c++ {
+				rows[r][c] = value
+			}
+		}
+	}
+	return nil
+}
+
+// applyMerges merges the configured blocks of cells; the value and style of the top-left cell are kept
+func applyMerges(f *excelize.File, layout sheetLayout, cfg *config, headers []string) error {
+	for _, spec := range cfg.merges {
+		topLeft, bottomRight, err := mergeRange(layout, spec, headers)
+		if err != nil {
+			return err
+		}
+		if err := f.MergeCell(layout.Sheet, topLeft, bottomRight); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// mergeRange resolves the top-left and bottom-right cells of a merge specification
+func mergeRange(layout sheetLayout, spec MergeSpec, headers []string) (string, string, error) {
+	if spec.Range != "" {
+		topLeft, bottomRight, ok := strings.Cut(spec.Range, ":")
+		if !ok || topLeft == "" || bottomRight == "" {
+			return "", "", fmt.Errorf("invalid merge range '%s'", spec.Range)
+		}
+		return topLeft, bottomRight, nil
+	}
+
+	columnIndex := func(header string) (int, error) {
+		for i, h := range headers {
+			if h == header {
+				return i, nil
+			}
+		}
+		return 0, fmt.Errorf("unknown merge column '%s'", header)
+	}
+
+	first, err := columnIndex(spec.Header)
+	if err != nil {
+		return "", "", err
+	}
+	last := first
+	if spec.ToHeader != "" {
+		if last, err = columnIndex(spec.ToHeader); err != nil {
+			return "", "", err
+		}
+	}
+	if last < first {
+		first, last = last, first
+	}
+
+	if spec.FromRow < 0 || spec.ToRow < spec.FromRow || spec.ToRow >= layout.Rows {
+		return "", "", fmt.Errorf("invalid merge rows %d-%d for %d data rows", spec.FromRow, spec.ToRow, layout.Rows)
+	}
+
+	return layout.cell(first, layout.firstDataRow()+spec.FromRow),
+		layout.cell(last, layout.firstDataRow()+spec.ToRow), nil
+}
@@ -0,0 +1,108 @@
+package xlsx_utilities
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/xuri/excelize/v2"
+)
+
+func TestMergeCells(t *testing.T) {
+	type sale struct {
+		Region string
+		Month  string
+		Amount int
+	}
+
+	data := []sale{
+		{Region: "North", Month: "Jan", Amount: 10},
+		{Region: "", Month: "Feb", Amount: 20},
+		{Region: "South", Month: "Jan", Amount: 30},
+	}
+
+	t.Run("Header and row range", func(t *testing.T) {
+		excelData, err := FromStruct(data)
+		assert.NoError(t, err)
+		excelData.MergeCells(MergeSpec{Header: "Region", FromRow: 0, ToRow: 1})
+
+		f, err := excelData.ToWorkbook(WithAnchor("B3"))
+		assert.NoError(t, err)
+		defer f.Close()
+
+		merged, err := f.GetMergeCells("Sheet1")
+		assert.NoError(t, err)
+		assert.Len(t, merged, 1)
+		assert.Equal(t, "B4", merged[0].GetStartAxis())
+		assert.Equal(t, "B5", merged[0].GetEndAxis())
+		assert.Equal(t, "North", merged[0].GetCellValue())
+	})
+
+	t.Run("Explicit range", func(t *testing.T) {
+		excelData, err := FromStruct(data)
+		assert.NoError(t, err)
+
+		f, err := excelData.ToWorkbook(WithMergeCells(MergeSpec{Range: "A1:C1"}))
+		assert.NoError(t, err)
+		defer f.Close()
+
+		merged, err := f.GetMergeCells("Sheet1")
+		assert.NoError(t, err)
+		assert.Len(t, merged, 1)
+		assert.Equal(t, "A1", merged[0].GetStartAxis())
+		assert.Equal(t, "C1", merged[0].GetEndAxis())
+	})
+
+	t.Run("Invalid specs", func(t *testing.T) {
+		excelData, err := FromStruct(data)
+		assert.NoError(t, err)
+
+		_, err = excelData.ToWorkbook(WithMergeCells(MergeSpec{Header: "Unknown", ToRow: 1}))
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "unknown merge column 'Unknown'")
+
+		_, err = excelData.ToWorkbook(WithMergeCells(MergeSpec{Header: "Region", FromRow: 1, ToRow: 3}))
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid merge rows")
+
+		_, err = excelData.ToWorkbook(WithMergeCells(MergeSpec{Range: "A1"}))
+		assert.Error(t, err)
+	})
+}
+
+func TestFillMergedCells(t *testing.T) {
+	type product struct {
+		Category string
+		Name     string
+		Price    int
+	}
+	filename := "test_fill_merged.xlsx"
+	defer os.Remove(filename)
+
+	f := excelize.NewFile()
+	assert.NoError(t, f.SetSheetRow("Sheet1", "A1", &[]interface{}{"Category", "Name", "Price"}))
+	assert.NoError(t, f.SetSheetRow("Sheet1", "A2", &[]interface{}{"Fruit", "Apple", 3}))
+	assert.NoError(t, f.SetSheetRow("Sheet1", "B3", &[]interface{}{"Pear"}))
+	assert.NoError(t, f.SetSheetRow("Sheet1", "A4", &[]interface{}{"Dairy", "Milk", 2}))
+	assert.NoError(t, f.SetSheetRow("Sheet1", "B5", &[]interface{}{"Cheese"}))
+	assert.NoError(t, f.MergeCell("Sheet1", "A2", "A3"))
+	assert.NoError(t, f.MergeCell("Sheet1", "C4", "C5"))
+	assert.NoError(t, f.MergeCell("Sheet1", "A4", "A5"))
+	assert.NoError(t, f.SaveAs(filename))
+	f.Close()
+
+	excelData, err := FromExcel[product](filename)
+	assert.NoError(t, err)
+	assert.Equal(t, "", excelData.ToStruct().Data[1].Category)
+
+	excelData, err = FromExcel[product](filename, WithFillMergedCells())
+	assert.NoError(t, err)
+	result := excelData.ToStruct()
+	assert.Empty(t, result.Errors)
+	assert.Equal(t, []product{
+		{"Fruit", "Apple", 3},
+		{"Fruit", "Pear", 0},
+		{"Dairy", "Milk", 2},
+		{"Dairy", "Cheese", 2},
+	}, result.Data)
+}
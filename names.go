@@ -0,0 +1,60 @@
+package xlsx_utilities
+
+import (
+	"fmt"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// namedRange is a workbook name defined over exported cells
+type namedRange struct {
+	name   string
+	header string
+}
+
+// WithNamedRange defines a workbook name over the data cells of the column with the given header,
+// e.g. "EmployeeIDs" referring to Sheet1!$A$2:$A$500, so other workbooks, formulas and validations
+// can refer to the export by name. An empty header names the whole exported block, headers included.
+// It can be given several times.
+func WithNamedRange(name, header string) Option {
+	return func(c *config) {
+		c.namedRanges = append(c.namedRanges, namedRange{name: name, header: header})
+	}
+}
+
+// applyNamedRanges defines the configured names over the written cells
+func applyNamedRanges(f *excelize.File, layout sheetLayout, cfg *config, headers []string) error {
+	for _, nr := range cfg.namedRanges {
+		first, last := layout.cell(0, layout.Row), layout.cell(max(layout.Cols-1, 0), max(layout.lastRow(), layout.Row))
+		if nr.header != "" {
+			col := -1
+			for i, header := range headers {
+				if header == nr.header {
+					col = i
+					break
+				}
+			}
+			if col == -1 {
+				return fmt.Errorf("unknown named range column '%s'", nr.header)
+			}
+			first, last = layout.cell(col, layout.firstDataRow()), layout.cell(col, max(layout.lastRow(), layout.firstDataRow()))
+		}
+
+		col1, row1, err := excelize.CellNameToCoordinates(first)
+		if err != nil {
+			return err
+		}
+		col2, row2, err := excelize.CellNameToCoordinates(last)
+		if err != nil {
+			return err
+		}
+
+		if err := f.SetDefinedName(&excelize.DefinedName{
+			Name:     nr.name,
+			RefersTo: absoluteRange(quoteSheetName(layout.Sheet), col1, row1, col2, row2),
+		}); err != nil {
+			return fmt.Errorf("error defining name '%s': %w", nr.name, err)
+		}
+	}
+	return nil
+}
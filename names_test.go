@@ -0,0 +1,33 @@
+package xlsx_utilities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNamedRanges(t *testing.T) {
+	excelData, err := FromStruct([]person{{Name: "Alice", Age: 30}, {Name: "Bob", Age: 25}})
+	assert.NoError(t, err)
+
+	t.Run("Column and block", func(t *testing.T) {
+		f, err := excelData.ToWorkbook(WithSheet("Staff List"), WithAnchor("B2"),
+			WithNamedRange("StaffNames", "Name"), WithNamedRange("Staff", ""))
+		assert.NoError(t, err)
+		defer f.Close()
+
+		refs := map[string]string{}
+		for _, name := range f.GetDefinedName() {
+			refs[name.Name] = name.RefersTo
+		}
+		assert.Equal(t, map[string]string{
+			"StaffNames": "'Staff List'!$B$3:$B$4",
+			"Staff":      "'Staff List'!$B$2:$C$4",
+		}, refs)
+	})
+
+	t.Run("Unknown column", func(t *testing.T) {
+		_, err := excelData.ToWorkbook(WithNamedRange("Missing", "Salary"))
+		assert.ErrorContains(t, err, "unknown named range column 'Salary'")
+	})
+}
@@ -0,0 +1,203 @@
+package xlsx_utilities
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// WithNativeTypes reads cells by the type stored in the workbook instead of inferring it from their
+// displayed text: numbers arrive as int or float64, booleans as bool and date cells as time.Time,
+// while text cells stay strings even when they look like numbers, e.g. "007". Error cells keep their
+// text. Cells whose text is changed on the way, such as by WithTrimSpace, WithFormulas or merged
+// continuation rows, are inferred as usual.
+func WithNativeTypes() Option {
+	return func(c *config) {
+		c.nativeTypes = true
+	}
+}
+
+// nativeCell is a cell read with its stored type
+type nativeCell struct {
+	text  string      // the text read for the cell
+	value interface{} // the typed value, nil for empty cells
+}
+
+// nativeRow holds the typed cells of a row read
+type nativeRow []nativeCell
+
+// value returns the typed value of the cell at column i when its text is still the one read
+func (r nativeRow) value(i int, text string) (interface{}, bool) {
+	if i >= len(r) || r[i].value == nil || r[i].text != text {
+		return nil, false
+	}
+	return r[i].value, true
+}
+
+// nativeRows finds the typed cells of the rows read by their sheet line, which is tracked through the
+// processing that drops, merges, windows or edits rows
+type nativeRows map[int]nativeRow
+
+// row returns the typed cells of the row read from the sheet line, or nil when it was not read with native types
+func (n nativeRows) row(line int) nativeRow {
+	return n[line]
+}
+
+// readNativeCells returns the typed cells of the sheet rows, in sheet orientation,
+// for the rows read with their displayed text
+func readNativeCells(f *excelize.File, cfg *config, rows [][]string) ([]nativeRow, error) {
+	sheet := cfg.sheet
+	rawCfg := *cfg
+	rawCfg.rawValues = true
+	raw, err := readRowsPrefix(f, &rawCfg, len(rows))
+	if err != nil {
+		return nil, err
+	}
+	props, err := f.GetWorkbookProps()
+	if err != nil {
+		return nil, err
+	}
+	date1904 := props.Date1904 != nil && *props.Date1904
+	dateStyles := make(map[int]bool)
+
+	grid := make([]nativeRow, len(rows))
+	for r, row := range rows {
+		grid[r] = make(nativeRow, len(row))
+		for c, text := range row {
+			grid[r][c].text = text
+			if r >= len(raw) || c >= len(raw[r]) || raw[r][c] == "" {
+				continue
+			}
+
+			cell, err := excelize.CoordinatesToCellName(c+1, r+1)
+			if err != nil {
+				return nil, err
+			}
+			cellType, err := f.GetCellType(sheet, cell)
+			if err != nil {
+				return nil, err
+			}
+
+			switch cellType {
+			case excelize.CellTypeUnset, excelize.CellTypeNumber:
+				date, err := isDateCell(f, sheet, cell, dateStyles)
+				if err != nil {
+					return nil, err
+				}
+				grid[r][c].value = nativeNumber(raw[r][c], date, date1904)
+			case excelize.CellTypeBool:
+				grid[r][c].value = raw[r][c] == "1" || strings.EqualFold(raw[r][c], "true")
+			case excelize.CellTypeDate:
+				if t, err := time.Parse(time.RFC3339Nano, raw[r][c]); err == nil {
+					grid[r][c].value = t
+				}
+			default:
+				// text, formula text and error cells keep the text read
+				grid[r][c].value = text
+			}
+		}
+	}
+	return grid, nil
+}
+
+// indexNativeRows maps the sheet line of the rows read, headers first, to their typed cells. With transposed
+// sheets the rows are the sheet columns; with grouped headers the two header rows were joined into one.
+func indexNativeRows(rows [][]string, grid []nativeRow, cfg *config) nativeRows {
+	if cfg.transposed {
+		width := 0
+		for _, row := range grid {
+			width = max(width, len(row))
+		}
+		transposed := make([]nativeRow, width)
+		for r, row := range grid {
+			for c, cell := range row {
+				for len(transposed[c]) <= r {
+					transposed[c] = append(transposed[c], nativeCell{})
+				}
+				transposed[c][r] = cell
+			}
+		}
+		grid = transposed
+	}
+
+	native := make(nativeRows, len(rows))
+	lines := sheetLines(len(rows), cfg)
+	for _, line := range lines[min(1, len(lines)):] {
+		if line-1 < len(grid) {
+			native[line] = grid[line-1]
+		}
+	}
+	return native
+}
+
+// isDateCell reports whether the number format of the cell shows a date or time
+func isDateCell(f *excelize.File, sheet, cell string, dateStyles map[int]bool) (bool, error) {
+	id, err := f.GetCellStyle(sheet, cell)
+	if err != nil || id == 0 {
+		return false, err
+	}
+	if date, ok := dateStyles[id]; ok {
+		return date, nil
+	}
+
+	style, err := f.GetStyle(id)
+	if err != nil {
+		return false, err
+	}
+	date := isDateNumFmt(style.NumFmt)
+	if style.CustomNumFmt != nil {
+		date = isDateFormatCode(*style.CustomNumFmt)
+	}
+	dateStyles[id] = date
+	return date, nil
+}
+
+// isDateNumFmt reports whether a built-in number format shows a date or time
+func isDateNumFmt(id int) bool {
+	return id >= 14 && id <= 22 || id >= 45 && id <= 47
+}
+
+// isDateFormatCode reports whether a custom number format shows a date or time,
+// ignoring quoted text, escaped characters and bracketed sections such as colors
+func isDateFormatCode(code string) bool {
+	quoted, bracketed := false, false
+	for i := 0; i < len(code); i++ {
+		switch ch := code[i]; {
+		case ch == '"':
+			quoted = !quoted
+		case quoted:
+		case ch == '\\':
+			i++
+		case ch == '[':
+			bracketed = true
+		case ch == ']':
+			bracketed = false
+		case bracketed:
+		case strings.IndexByte("yYmMdDhHsS", ch) >= 0:
+			return true
+		}
+	}
+	return false
+}
+
+// nativeNumber converts the stored value of a number cell to an int, a float64 or, for date cells, a time.Time
+func nativeNumber(raw string, date, date1904 bool) interface{} {
+	n, err := strconv.ParseFloat(raw, 64)
+	if err != nil {
+		return nil
+	}
+	if date {
+		t, err := excelize.ExcelDateToTime(n, date1904)
+		if err != nil {
+			return nil
+		}
+		return t
+	}
+	if n == math.Trunc(n) && math.Abs(n) < 1<<53 {
+		return int(n)
+	}
+	return n
+}
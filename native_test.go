@@ -0,0 +1,102 @@
+package xlsx_utilities
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/xuri/excelize/v2"
+)
+
+func TestNativeTypes(t *testing.T) {
+	type order struct {
+		Code     string
+		Quantity int
+		Price    float64
+		Paid     bool
+		Ordered  time.Time
+		Note     string
+	}
+	filename := "test_native_types.xlsx"
+	defer os.Remove(filename)
+
+	ordered := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	f := excelize.NewFile()
+	assert.NoError(t, f.SetSheetRow("Sheet1", "A1", &[]interface{}{"Code", "Quantity", "Price", "Paid", "Ordered", "Note"}))
+	assert.NoError(t, f.SetSheetRow("Sheet1", "A2", &[]interface{}{"007", 3, 2.5, true, ordered, "TRUE"}))
+	assert.NoError(t, f.SetSheetRow("Sheet1", "A3", &[]interface{}{"1e5", 12, 4, false, ordered.AddDate(0, 0, 1), "12"}))
+	assert.NoError(t, f.SetCellValue("Sheet1", "A4", "  008"))
+	assert.NoError(t, f.SaveAs(filename))
+	f.Close()
+
+	excelData, err := FromExcel[order](filename, WithNativeTypes())
+	assert.NoError(t, err)
+	assert.Equal(t, []interface{}{"007", 3, 2.5, true, ordered, "TRUE"}, excelData.Rows[0])
+	assert.Equal(t, "1e5", excelData.Rows[1][0])
+	assert.Equal(t, 4, excelData.Rows[1][2])
+	assert.Equal(t, false, excelData.Rows[1][3])
+	assert.Equal(t, "12", excelData.Rows[1][5])
+
+	result := excelData.ToStruct()
+	assert.Empty(t, result.Errors)
+	assert.Equal(t, order{"007", 3, 2.5, true, ordered, "TRUE"}, result.Data[0])
+
+	t.Run("Inferred", func(t *testing.T) {
+		excelData, err := FromExcel[order](filename)
+		assert.NoError(t, err)
+		assert.Equal(t, 7, excelData.Rows[0][0])
+		assert.Equal(t, true, excelData.Rows[0][5])
+	})
+
+	t.Run("ChangedText", func(t *testing.T) {
+		excelData, err := FromExcel[order](filename, WithNativeTypes(), WithTrimSpace(), WithSkipBlankRows())
+		assert.NoError(t, err)
+		assert.Equal(t, "007", excelData.Rows[0][0])
+		assert.Equal(t, 8, excelData.Rows[2][0])
+	})
+
+	t.Run("Transposed", func(t *testing.T) {
+		transposed := "test_native_types_transposed.xlsx"
+		defer os.Remove(transposed)
+		f := excelize.NewFile()
+		assert.NoError(t, f.SetSheetCol("Sheet1", "A1", &[]interface{}{"Code", "Quantity", "Ordered"}))
+		assert.NoError(t, f.SetSheetCol("Sheet1", "B1", &[]interface{}{"007", 3, ordered}))
+		assert.NoError(t, f.SaveAs(transposed))
+		f.Close()
+
+		excelData, err := FromExcel[order](transposed, WithNativeTypes(), WithTransposed())
+		assert.NoError(t, err)
+		assert.Equal(t, []interface{}{"007", 3, ordered}, excelData.Rows[0])
+	})
+
+	t.Run("Filled down and merged rows", func(t *testing.T) {
+		grouped := "test_native_types_grouped.xlsx"
+		defer os.Remove(grouped)
+		f := excelize.NewFile()
+		assert.NoError(t, f.SetSheetRow("Sheet1", "A1", &[]interface{}{"Code", "Ordered", "Note", "Region"}))
+		assert.NoError(t, f.SetSheetRow("Sheet1", "A2", &[]interface{}{"007", ordered, "first", "North"}))
+		assert.NoError(t, f.SetSheetRow("Sheet1", "A3", &[]interface{}{"008", ordered.AddDate(0, 0, 1), "second"}))
+		assert.NoError(t, f.SetSheetRow("Sheet1", "A4", &[]interface{}{nil, nil, "line"}))
+		assert.NoError(t, f.SaveAs(grouped))
+		f.Close()
+
+		excelData, err := FromExcel[order](grouped, WithNativeTypes(), WithFillDown("Region"), WithContinuationRows(" ", "Note"))
+		assert.NoError(t, err)
+		assert.Equal(t, []interface{}{"007", ordered, "first", "North"}, excelData.Rows[0])
+		assert.Equal(t, []interface{}{"008", ordered.AddDate(0, 0, 1), "second line", "North"}, excelData.Rows[1])
+
+		// rows streamed for a window keep their typed cells as well
+		excelData, err = FromExcel[order](grouped, WithNativeTypes(), WithFillDown("Region"), WithLimit(2))
+		assert.NoError(t, err)
+		assert.Equal(t, []interface{}{"008", ordered.AddDate(0, 0, 1), "second", "North"}, excelData.Rows[1])
+	})
+}
+
+func TestIsDateFormatCode(t *testing.T) {
+	assert.True(t, isDateFormatCode("yyyy-mm-dd"))
+	assert.True(t, isDateFormatCode("[h]:mm"))
+	assert.False(t, isDateFormatCode("General"))
+	assert.False(t, isDateFormatCode(`#,##0 "days"`))
+	assert.False(t, isDateFormatCode("[Red]0.00"))
+}
@@ -0,0 +1,78 @@
+package xlsx_utilities
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"reflect"
+	"strings"
+)
+
+// WithNullValues makes cells holding one of the values, e.g. "N/A", "-" or "null", import as missing:
+// the field is not set, so pointers stay nil and other fields keep their zero value.
+// Values are compared case-insensitively after trimming white space.
+func WithNullValues(values ...string) Option {
+	return func(c *config) {
+		if c.nullValues == nil {
+			c.nullValues = make(map[string]bool)
+		}
+		for _, value := range values {
+			c.nullValues[strings.ToLower(strings.TrimSpace(value))] = true
+		}
+	}
+}
+
+// isNullValue reports whether the cell value is one of the configured null values
+func isNullValue(value interface{}, nulls map[string]bool) bool {
+	text, ok := value.(string)
+	return ok && len(nulls) > 0 && nulls[strings.ToLower(strings.TrimSpace(text))]
+}
+
+// nullTypes are the sql.Null* types handled natively: NULL is written as a blank cell
+// and a blank cell is read as NULL
+var nullTypes = map[reflect.Type]bool{
+	reflect.TypeOf(sql.NullString{}):  true,
+	reflect.TypeOf(sql.NullInt64{}):   true,
+	reflect.TypeOf(sql.NullInt32{}):   true,
+	reflect.TypeOf(sql.NullInt16{}):   true,
+	reflect.TypeOf(sql.NullByte{}):    true,
+	reflect.TypeOf(sql.NullFloat64{}): true,
+	reflect.TypeOf(sql.NullBool{}):    true,
+	reflect.TypeOf(sql.NullTime{}):    true,
+}
+
+// nullConverter writes the value of a valid sql.Null* value, or nil for NULL.
+// Times are written through the time.Time converter, like time.Time fields.
+func nullConverter(i interface{}) (interface{}, error) {
+	value, err := i.(driver.Valuer).Value()
+	if err != nil || value == nil {
+		return nil, err
+	}
+	if converter, ok := TypeConverters[reflect.TypeOf(value)]; ok {
+		return converter(value)
+	}
+	return value, nil
+}
+
+// nullParser parses a cell into a valid sql.Null* value of type t; blank cells leave it NULL
+func nullParser(t reflect.Type) CustomTypeParser {
+	return func(s string) (interface{}, error) {
+		if s == "" {
+			return nil, nil
+		}
+
+		var value interface{} = s
+		if t == reflect.TypeOf(sql.NullTime{}) {
+			parsed, _, err := parseTimeLenient(s)
+			if err != nil {
+				return nil, err
+			}
+			value = parsed
+		}
+
+		v := reflect.New(t)
+		if err := v.Interface().(sql.Scanner).Scan(value); err != nil {
+			return nil, err
+		}
+		return v.Elem().Interface(), nil
+	}
+}
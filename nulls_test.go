@@ -0,0 +1,74 @@
+package xlsx_utilities
+
+import (
+	"database/sql"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNullValues(t *testing.T) {
+	type reading struct {
+		Sensor string
+		Value  *float64
+		Count  int
+	}
+
+	excelData := NewExcelData[reading]([]string{"Sensor", "Value", "Count"})
+	excelData.AddRow([]interface{}{"a", "1.5", "3"})
+	excelData.AddRow([]interface{}{"b", "N/A", " - "})
+	excelData.AddRow([]interface{}{"c", "NULL", "2"})
+
+	result := excelData.WithOptions(WithNullValues("N/A", "-", "null")).ToStruct()
+	assert.Empty(t, result.Errors)
+	assert.Empty(t, result.CellWarnings)
+
+	value := 1.5
+	assert.Equal(t, []reading{
+		{"a", &value, 3},
+		{"b", nil, 0},
+		{"c", nil, 2},
+	}, result.Data)
+}
+
+func TestNullTypes(t *testing.T) {
+	type record struct {
+		Name    sql.NullString
+		Count   sql.NullInt64
+		Score   sql.NullFloat64
+		Active  sql.NullBool
+		Updated sql.NullTime
+	}
+
+	filename := "test_nulls.xlsx"
+	defer os.Remove(filename)
+
+	updated := time.Date(2024, 3, 1, 10, 30, 0, 0, time.UTC)
+	data := []record{
+		{
+			Name:    sql.NullString{String: "Alice", Valid: true},
+			Count:   sql.NullInt64{Int64: 42, Valid: true},
+			Score:   sql.NullFloat64{Float64: 1.5, Valid: true},
+			Active:  sql.NullBool{Bool: true, Valid: true},
+			Updated: sql.NullTime{Time: updated, Valid: true},
+		},
+		{Name: sql.NullString{String: "Bob", Valid: true}},
+	}
+
+	excelData, err := FromStruct(data)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Name", "Count", "Score", "Active", "Updated"}, excelData.Headers)
+	assert.Equal(t, []interface{}{"Alice", int64(42), 1.5, true, "2024-03-01T10:30:00Z"}, excelData.Rows[0])
+	assert.Equal(t, []interface{}{"Bob", nil, nil, nil, nil}, excelData.Rows[1])
+
+	assert.NoError(t, excelData.Save(filename))
+
+	imported, err := FromExcel[record](filename)
+	assert.NoError(t, err)
+
+	result := imported.ToStruct()
+	assert.Empty(t, result.Errors)
+	assert.Equal(t, data, result.Data)
+}
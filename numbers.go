@@ -0,0 +1,168 @@
+package xlsx_utilities
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// NumberStyle describes how numbers are written as text in a column, e.g. "45%", "$1,250.00" or "€1.250,00"
+type NumberStyle struct {
+	Percent       bool // a trailing % divides the value by 100, so "45%" reads as 0.45
+	PercentPoints bool // a trailing % is dropped without dividing, so "45%" reads as 45
+	Currency      bool // currency symbols such as "$" or "€", and a code such as "USD" or "zł" before or after the number, are removed
+	Decimal       rune // decimal separator; '.' when not set
+	Thousands     rune // digit grouping separator; ',' when not set, or '.' when Decimal is ','
+}
+
+// numberStyles holds the configured styles by column header and by field type
+type numberStyles struct {
+	columns map[string]NumberStyle
+	types   map[reflect.Type]NumberStyle
+	all     *NumberStyle
+}
+
+// WithNumberStyle parses numeric fields of the given columns with the style on import, stripping
+// percent signs, currency symbols and digit grouping before conversion. Without columns, the style
+// applies to every numeric field that has no column or type specific style.
+func WithNumberStyle(style NumberStyle, columns ...string) Option {
+	return func(c *config) {
+		styles := c.ensureNumberStyles()
+		if len(columns) == 0 {
+			styles.all = &style
+		}
+		for _, column := range columns {
+			styles.columns[column] = style
+		}
+	}
+}
+
+// WithTypeNumberStyle parses the fields of type t, e.g. a Money type, with the style on import.
+// Column styles set by WithNumberStyle take precedence.
+func WithTypeNumberStyle(t reflect.Type, style NumberStyle) Option {
+	return func(c *config) {
+		c.ensureNumberStyles().types[t] = style
+	}
+}
+
+// ensureNumberStyles returns the number styles of the config, creating them on first use
+func (c *config) ensureNumberStyles() *numberStyles {
+	if c.numberStyles == nil {
+		c.numberStyles = &numberStyles{
+			columns: make(map[string]NumberStyle),
+			types:   make(map[reflect.Type]NumberStyle),
+		}
+	}
+	return c.numberStyles
+}
+
+// styleFor returns the style of a column whose field has type t, or nil when it is not numeric or has no style
+func (s *numberStyles) styleFor(header string, t reflect.Type) *NumberStyle {
+	if s == nil || t == nil || !isNumericKind(t.Kind()) {
+		return nil
+	}
+	if style, ok := s.columns[header]; ok {
+		return &style
+	}
+	if style, ok := s.types[t]; ok {
+		return &style
+	}
+	return s.all
+}
+
+// isNumericKind reports whether values of the kind are parsed as numbers
+func isNumericKind(k reflect.Kind) bool {
+	switch k {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
+
+// parse returns the plain number written in text, e.g. "1250.5" for "$1,250.50" and "1250" for "1.250,00 €".
+// It reports false when the text is not a number in this style.
+func (s NumberStyle) parse(text string) (string, bool) {
+	decimal, thousands := s.Decimal, s.Thousands
+	if decimal == 0 {
+		decimal = '.'
+	}
+	if thousands == 0 {
+		thousands = ','
+		if decimal == ',' {
+			thousands = '.'
+		}
+	}
+
+	text = strings.TrimSpace(text)
+	percent := (s.Percent || s.PercentPoints) && strings.HasSuffix(text, "%")
+	if percent {
+		text = strings.TrimSuffix(text, "%")
+	}
+
+	negative := strings.HasPrefix(text, "(") && strings.HasSuffix(text, ")")
+	if negative {
+		text = text[1 : len(text)-1]
+	}
+	if s.Currency {
+		text = trimCurrencyCode(text)
+	}
+
+	var b strings.Builder
+	for _, r := range text {
+		switch {
+		case r == decimal:
+			b.WriteByte('.')
+		case r == thousands || unicode.IsSpace(r):
+		case s.Currency && unicode.Is(unicode.Sc, r):
+		default:
+			b.WriteRune(r)
+		}
+	}
+
+	number := b.String()
+	if negative {
+		number = "-" + number
+	}
+
+	f, err := strconv.ParseFloat(number, 64)
+	if err != nil {
+		return "", false
+	}
+	if percent && !s.PercentPoints {
+		f /= 100
+	}
+	return strconv.FormatFloat(f, 'f', -1, 64), true
+}
+
+// maxCurrencyCode is the length of the longest currency code removed, in letters
+const maxCurrencyCode = 3
+
+// trimCurrencyCode removes a currency code of up to three letters, e.g. "USD" or "zł", from the start or
+// end of text. Longer words are kept, so texts like "Order 12" do not read as numbers.
+func trimCurrencyCode(text string) string {
+	rest := strings.TrimLeftFunc(text, unicode.IsLetter)
+	if code := text[:len(text)-len(rest)]; utf8.RuneCountInString(code) <= maxCurrencyCode {
+		text = rest
+	}
+	rest = strings.TrimRightFunc(text, unicode.IsLetter)
+	if code := text[len(rest):]; utf8.RuneCountInString(code) <= maxCurrencyCode {
+		text = rest
+	}
+	return text
+}
+
+// applyNumberStyle rewrites a text cell value written in the style as a plain number, leaving other values as they are
+func applyNumberStyle(style *NumberStyle, value interface{}) interface{} {
+	text, ok := value.(string)
+	if style == nil || !ok {
+		return value
+	}
+	if number, ok := style.parse(text); ok {
+		return number
+	}
+	return value
+}
@@ -0,0 +1,64 @@
+package xlsx_utilities
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNumberStyle(t *testing.T) {
+	t.Run("Parse", func(t *testing.T) {
+		tests := []struct {
+			style NumberStyle
+			text  string
+			want  string
+			ok    bool
+		}{
+			{NumberStyle{Percent: true}, "45%", "0.45", true},
+			{NumberStyle{PercentPoints: true}, "45%", "45", true},
+			{NumberStyle{Percent: true}, "0.5", "0.5", true},
+			{NumberStyle{Currency: true}, "$1,250.00", "1250", true},
+			{NumberStyle{Currency: true}, "(USD 12.50)", "-12.5", true},
+			{NumberStyle{Currency: true, Decimal: ','}, "€1.250,75", "1250.75", true},
+			{NumberStyle{Currency: true, Decimal: ',', Thousands: ' '}, "1 250,75 zł", "1250.75", true},
+			{NumberStyle{}, "$12", "", false},
+			{NumberStyle{Currency: true}, "n/a", "", false},
+			{NumberStyle{Currency: true}, "Order 12", "", false},
+			{NumberStyle{Currency: true}, "12 pieces", "", false},
+			{NumberStyle{Currency: true}, "1e5", "100000", true},
+			{NumberStyle{Currency: true}, "US$ 12", "12", true},
+		}
+
+		for _, tt := range tests {
+			got, ok := tt.style.parse(tt.text)
+			assert.Equal(t, tt.ok, ok, tt.text)
+			assert.Equal(t, tt.want, got, tt.text)
+		}
+	})
+
+	t.Run("Import", func(t *testing.T) {
+		type money float64
+		type invoice struct {
+			Item     string
+			Price    money
+			Discount float64
+			Tax      float64
+			Quantity int
+		}
+
+		excelData := NewExcelData[invoice]([]string{"Item", "Price", "Discount", "Tax", "Quantity"})
+		excelData.AddRow([]interface{}{"Desk", "€1.250,00", "10%", "7.5%", "1,000"})
+
+		result := excelData.WithOptions(
+			WithNumberStyle(NumberStyle{}),
+			WithTypeNumberStyle(reflect.TypeOf(money(0)), NumberStyle{Currency: true, Decimal: ','}),
+			WithNumberStyle(NumberStyle{Percent: true}, "Discount"),
+			WithNumberStyle(NumberStyle{PercentPoints: true}, "Tax"),
+		).ToStruct()
+
+		assert.Empty(t, result.Errors)
+		assert.Empty(t, result.CellWarnings)
+		assert.Equal(t, []invoice{{Item: "Desk", Price: 1250, Discount: 0.1, Tax: 7.5, Quantity: 1000}}, result.Data)
+	})
+}
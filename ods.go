@@ -0,0 +1,441 @@
+package xlsx_utilities
+
+import (
+	"archive/zip"
+	"bufio"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// ODSContentType is the MIME type of OpenDocument spreadsheets
+const ODSContentType = "application/vnd.oasis.opendocument.spreadsheet"
+
+// OpenDocument namespaces used in content.xml
+const (
+	odsOfficeNS = "urn:oasis:names:tc:opendocument:xmlns:office:1.0"
+	odsTableNS  = "urn:oasis:names:tc:opendocument:xmlns:table:1.0"
+	odsTextNS   = "urn:oasis:names:tc:opendocument:xmlns:text:1.0"
+)
+
+// odsDateLayout is the layout of office:date-value attributes
+const odsDateLayout = "2006-01-02T15:04:05"
+
+const odsManifest = `<?xml version="1.0" encoding="UTF-8"?>
+<manifest:manifest xmlns:manifest="urn:oasis:names:tc:opendocument:xmlns:manifest:1.0" manifest:version="1.2">
+ <manifest:file-entry manifest:full-path="/" manifest:version="1.2" manifest:media-type="` + ODSContentType + `"/>
+ <manifest:file-entry manifest:full-path="content.xml" manifest:media-type="text/xml"/>
+</manifest:manifest>
+`
+
+// FromODS reads an OpenDocument spreadsheet (.ods) into ExcelData.
+// The sheet set by WithSheet is read, falling back to the first sheet when the default sheet name is not present.
+// Rows go through the same text options as FromExcel, so the result maps to structs with ToStruct as usual,
+// and the upload limits such as WithMaxRows apply as well.
+func FromODS[T comparable](filename string, opts ...Option) (*ExcelData[T], error) {
+	file, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return nil, err
+	}
+
+	return FromFileODS[T](file, info.Size(), opts...)
+}
+
+// FromFileODS reads an OpenDocument spreadsheet from a reader of the given size
+func FromFileODS[T comparable](r io.ReaderAt, size int64, opts ...Option) (*ExcelData[T], error) {
+	cfg := newConfig(opts)
+	if err := cfg.limits.checkArchive(r, size); err != nil {
+		return nil, err
+	}
+
+	z, err := zip.NewReader(r, size)
+	if err != nil {
+		return nil, err
+	}
+
+	return fromODS[T](z, cfg, opts)
+}
+
+// fromODS reads the configured sheet of the content.xml in an ODS package
+func fromODS[T comparable](z *zip.Reader, cfg *config, opts []Option) (*ExcelData[T], error) {
+	content, err := z.Open("content.xml")
+	if err != nil {
+		return nil, fmt.Errorf("invalid ods file: %w", err)
+	}
+	defer content.Close()
+
+	rows, err := readODSRows(content, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, lines := processRows(rows, sheetLines(len(rows), cfg), cfg)
+	return fromRows[T](rows, lines, nil, nil, opts)
+}
+
+// odsTable collects the rows of one table while decoding content.xml
+type odsTable struct {
+	cfg        *config
+	rows       [][]string
+	row        []string
+	emptyRows  int
+	emptyCells int
+}
+
+// addCells appends a cell repeated n times. Empty cells are held back until a non-empty cell follows.
+// Repeats are expanded only as far as the sheet and the column limit allow.
+func (t *odsTable) addCells(value string, n int) error {
+	n = min(n, excelize.MaxColumns+1)
+	if value == "" {
+		t.emptyCells = min(t.emptyCells+n, excelize.MaxColumns+1)
+		return nil
+	}
+	if err := t.checkColumns(len(t.row) + t.emptyCells + n); err != nil {
+		return err
+	}
+	for ; t.emptyCells > 0; t.emptyCells-- {
+		t.row = append(t.row, "")
+	}
+	for i := 0; i < n; i++ {
+		t.row = append(t.row, value)
+	}
+	return nil
+}
+
+// endRow appends the current row repeated n times. Empty rows are held back until a non-empty row follows.
+// Repeats are expanded only as far as the sheet and the row limit allow.
+func (t *odsTable) endRow(n int) error {
+	n = min(n, excelize.TotalRows+1)
+	if len(t.row) == 0 {
+		t.emptyRows = min(t.emptyRows+n, excelize.TotalRows+1)
+	} else {
+		if err := t.checkRows(len(t.rows) + t.emptyRows + n); err != nil {
+			return err
+		}
+		for ; t.emptyRows > 0; t.emptyRows-- {
+			t.rows = append(t.rows, []string{})
+		}
+		for i := 0; i < n; i++ {
+			t.rows = append(t.rows, append([]string(nil), t.row...))
+		}
+	}
+	t.row, t.emptyCells = nil, 0
+	return nil
+}
+
+// checkRows fails when n rows, header rows included, exceed the WithMaxRows limit or the rows of a sheet
+func (t *odsTable) checkRows(n int) error {
+	limits := t.cfg.limits
+	if limits.rows > 0 && n-t.cfg.headerRows() > limits.rows {
+		return fmt.Errorf("%w: sheet '%s' has more than %d data rows", ErrLimitExceeded, t.cfg.sheet, limits.rows)
+	}
+	if n > excelize.TotalRows {
+		return fmt.Errorf("%w: sheet '%s' has more than %d rows", ErrLimitExceeded, t.cfg.sheet, excelize.TotalRows)
+	}
+	return nil
+}
+
+// checkColumns fails when a row of n cells exceeds the WithMaxColumns limit or the columns of a sheet
+func (t *odsTable) checkColumns(n int) error {
+	limit := t.cfg.limits.cols
+	if limit <= 0 || limit > excelize.MaxColumns {
+		limit = excelize.MaxColumns
+	}
+	if n > limit {
+		return fmt.Errorf("%w: sheet '%s' has more than %d columns", ErrLimitExceeded, t.cfg.sheet, limit)
+	}
+	return nil
+}
+
+// readODSRows returns the cell text of the configured table, falling back to the first table when the default sheet
+// name is not present. Repeated rows and cells are expanded, except trailing empty ones which only pad the sheet.
+func readODSRows(r io.Reader, cfg *config) ([][]string, error) {
+	sheet, fallback := cfg.sheet, cfg.sheet == defaultSheet
+	dec := xml.NewDecoder(r)
+
+	var (
+		tables    int
+		named     bool
+		reading   bool
+		first     [][]string
+		found     bool
+		table     odsTable
+		rowRepeat int
+		colRepeat int
+		value     string
+		typed     bool
+		inCell    bool
+		paras     int
+		text      strings.Builder
+	)
+
+	for {
+		tok, err := dec.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid ods content: %w", err)
+		}
+
+		switch t := tok.(type) {
+		case xml.StartElement:
+			switch {
+			case isODSElement(t.Name, odsTableNS, "table"):
+				tables++
+				named = odsAttr(t, odsTableNS, "name") == sheet
+				reading = named || (fallback && tables == 1)
+				table = odsTable{cfg: cfg}
+			case !reading:
+			case isODSElement(t.Name, odsTableNS, "table-row"):
+				rowRepeat = odsRepeat(t, "number-rows-repeated")
+			case isODSCell(t.Name):
+				inCell, paras = true, 0
+				text.Reset()
+				value, typed = odsCellValue(t)
+				colRepeat = odsRepeat(t, "number-columns-repeated")
+			case inCell && t.Name.Space == odsTextNS:
+				switch t.Name.Local {
+				case "p":
+					if paras > 0 {
+						text.WriteByte('\n')
+					}
+					paras++
+				case "s":
+					text.WriteString(strings.Repeat(" ", min(odsRepeat(t, "c"), excelize.TotalCellChars)))
+				case "tab":
+					text.WriteByte('\t')
+				case "line-break":
+					text.WriteByte('\n')
+				}
+			}
+		case xml.CharData:
+			if reading && inCell && paras > 0 {
+				text.Write(t)
+			}
+		case xml.EndElement:
+			switch {
+			case !reading:
+			case isODSCell(t.Name):
+				if !typed {
+					value = text.String()
+				}
+				if err := table.addCells(value, colRepeat); err != nil {
+					return nil, err
+				}
+				inCell = false
+			case isODSElement(t.Name, odsTableNS, "table-row"):
+				if err := table.endRow(rowRepeat); err != nil {
+					return nil, err
+				}
+			case isODSElement(t.Name, odsTableNS, "table"):
+				if named {
+					return table.rows, nil
+				}
+				first, found = table.rows, true
+				reading = false
+			}
+		}
+	}
+
+	if !found {
+		return nil, fmt.Errorf("sheet '%s' does not exist", sheet)
+	}
+	return first, nil
+}
+
+// isODSElement reports whether name is the given element
+func isODSElement(name xml.Name, space, local string) bool {
+	return name.Space == space && name.Local == local
+}
+
+// isODSCell reports whether name is a table cell, including cells covered by a merge
+func isODSCell(name xml.Name) bool {
+	return isODSElement(name, odsTableNS, "table-cell") || isODSElement(name, odsTableNS, "covered-table-cell")
+}
+
+// odsAttr returns the value of the attribute, or "" when it is not set
+func odsAttr(e xml.StartElement, space, local string) string {
+	for _, attr := range e.Attr {
+		if attr.Name.Space == space && attr.Name.Local == local {
+			return attr.Value
+		}
+	}
+	return ""
+}
+
+// odsRepeat returns the repeat count stored in the attribute, defaulting to 1
+func odsRepeat(e xml.StartElement, local string) int {
+	space := odsTableNS
+	if local == "c" {
+		space = odsTextNS
+	}
+	n, err := strconv.Atoi(odsAttr(e, space, local))
+	if err != nil || n < 1 {
+		return 1
+	}
+	return n
+}
+
+// odsCellValue returns the text of a typed cell from its value attribute.
+// Dates are returned as RFC 3339 so they map to time.Time fields; string cells report false and are read from their paragraphs.
+func odsCellValue(e xml.StartElement) (string, bool) {
+	switch odsAttr(e, odsOfficeNS, "value-type") {
+	case "float", "percentage", "currency":
+		return odsAttr(e, odsOfficeNS, "value"), true
+	case "boolean":
+		return odsAttr(e, odsOfficeNS, "boolean-value"), true
+	case "date":
+		date := odsAttr(e, odsOfficeNS, "date-value")
+		for _, layout := range []string{time.RFC3339Nano, odsDateLayout, "2006-01-02T15:04:05.999999999", time.DateOnly} {
+			if t, err := time.Parse(layout, date); err == nil {
+				return t.Format(time.RFC3339), true
+			}
+		}
+		return date, true
+	}
+	return "", false
+}
+
+// ToODS writes the data to an OpenDocument spreadsheet (.ods) readable by LibreOffice.
+// Only cell values are written: styles, tables, pivot tables, charts, merges and the other workbook decorations are xlsx only.
+func (ed *ExcelData[T]) ToODS(filename string, opts ...Option) error {
+	return writeFileAtomic(filename, ed.config(opts...).fsync, func(w io.Writer) error {
+		return ed.WriteODS(w, opts...)
+	})
+}
+
+// WriteODS writes the data as an OpenDocument spreadsheet to w
+func (ed *ExcelData[T]) WriteODS(w io.Writer, opts ...Option) error {
+	cfg := ed.config(opts...)
+
+	ed, err := ed.beforeWrite(cfg)
+	if err != nil {
+		return err
+	}
+	ed = ed.forViewer(cfg)
+
+	z := zip.NewWriter(w)
+
+	// The mimetype must be the first entry and stored uncompressed
+	mimetype, err := z.CreateHeader(&zip.FileHeader{Name: "mimetype", Method: zip.Store})
+	if err != nil {
+		return err
+	}
+	if _, err := io.WriteString(mimetype, ODSContentType); err != nil {
+		return err
+	}
+
+	manifest, err := z.Create("META-INF/manifest.xml")
+	if err != nil {
+		return err
+	}
+	if _, err := io.WriteString(manifest, odsManifest); err != nil {
+		return err
+	}
+
+	content, err := z.Create("content.xml")
+	if err != nil {
+		return err
+	}
+	if err := ed.writeODSContent(content, cfg); err != nil {
+		return err
+	}
+
+	return z.Close()
+}
+
+// writeODSContent writes content.xml with a single table holding the headers and rows
+func (ed *ExcelData[T]) writeODSContent(w io.Writer, cfg *config) error {
+	b := bufio.NewWriter(w)
+
+	b.WriteString(xml.Header)
+	b.WriteString(`<office:document-content xmlns:office="` + odsOfficeNS + `" xmlns:table="` + odsTableNS + `" xmlns:text="` + odsTextNS + `" office:version="1.2">`)
+	b.WriteString(`<office:body><office:spreadsheet><table:table table:name="`)
+	xml.EscapeText(b, []byte(cfg.sheet))
+	b.WriteString(`">`)
+
+	if !cfg.skipHeaders {
+		b.WriteString(`<table:table-row>`)
+		for _, header := range headerLabels(reflect.TypeOf((*T)(nil)).Elem(), ed.Headers, cfg) {
+			writeODSCell(b, header)
+		}
+		b.WriteString(`</table:table-row>`)
+	}
+
+	for _, row := range ed.Rows {
+		b.WriteString(`<table:table-row>`)
+		for _, value := range row {
+			value = exportText(value, cfg)
+			writeODSCell(b, localizeValue(value, cfg))
+		}
+		b.WriteString(`</table:table-row>`)
+	}
+
+	b.WriteString(`</table:table></office:spreadsheet></office:body></office:document-content>`)
+	return b.Flush()
+}
+
+// writeODSCell writes a single cell typed after the Go value
+func writeODSCell(b *bufio.Writer, value interface{}) {
+	switch v := value.(type) {
+	case nil:
+		b.WriteString(`<table:table-cell/>`)
+		return
+	case bool:
+		fmt.Fprintf(b, `<table:table-cell office:value-type="boolean" office:boolean-value="%t">`, v)
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64:
+		fmt.Fprintf(b, `<table:table-cell office:value-type="float" office:value="%v">`, v)
+	case time.Time:
+		fmt.Fprintf(b, `<table:table-cell office:value-type="date" office:date-value="%s">`, v.Format(odsDateLayout))
+	default:
+		b.WriteString(`<table:table-cell office:value-type="string">`)
+	}
+
+	for _, line := range strings.Split(fmt.Sprint(value), "\n") {
+		b.WriteString(`<text:p>`)
+		writeODSText(b, line)
+		b.WriteString(`</text:p>`)
+	}
+	b.WriteString(`</table:table-cell>`)
+}
+
+// writeODSText writes a paragraph's text. Readers collapse white space in paragraphs,
+// so tabs and runs of spaces other than a single inner space are written as elements.
+func writeODSText(b *bufio.Writer, line string) {
+	for i := 0; i < len(line); {
+		switch {
+		case line[i] == '\t':
+			b.WriteString(`<text:tab/>`)
+			i++
+		case line[i] == ' ':
+			n := len(line[i:]) - len(strings.TrimLeft(line[i:], " "))
+			if n == 1 && i > 0 && i+1 < len(line) {
+				b.WriteByte(' ')
+			} else {
+				fmt.Fprintf(b, `<text:s text:c="%d"/>`, n)
+			}
+			i += n
+		default:
+			end := strings.IndexAny(line[i:], " \t")
+			if end < 0 {
+				end = len(line) - i
+			}
+			xml.EscapeText(b, []byte(line[i:i+end]))
+			i += end
+		}
+	}
+}
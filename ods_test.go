@@ -0,0 +1,115 @@
+package xlsx_utilities
+
+import (
+	"archive/zip"
+	"bytes"
+	"io"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type member struct {
+	Name   string
+	Age    int
+	Active bool
+	Joined time.Time
+}
+
+func TestODS(t *testing.T) {
+	filename := "test_members.ods"
+	defer os.Remove(filename)
+
+	joined := time.Date(2024, 3, 1, 9, 30, 0, 0, time.UTC)
+	members := []member{
+		{Name: "Alice & Bob", Age: 30, Active: true, Joined: joined},
+		{Name: "Line one\n  indented\ttab", Age: 25, Joined: joined.AddDate(0, 1, 0)},
+	}
+
+	t.Run("Round trip", func(t *testing.T) {
+		excelData, err := FromStruct(members)
+		assert.NoError(t, err)
+		assert.NoError(t, excelData.ToODS(filename))
+
+		imported, err := FromODS[member](filename)
+		assert.NoError(t, err)
+		assert.Equal(t, excelData.Headers, imported.Headers)
+
+		result := imported.ToStruct()
+		assert.Empty(t, result.Errors)
+		assert.Equal(t, members, result.Data)
+	})
+
+	t.Run("Package layout", func(t *testing.T) {
+		var buf bytes.Buffer
+		excelData := NewExcelData[member]([]string{"Name"})
+		assert.NoError(t, excelData.WriteODS(&buf, WithSheet("Members")))
+
+		z, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+		assert.NoError(t, err)
+		assert.Equal(t, "mimetype", z.File[0].Name)
+		assert.Equal(t, zip.Store, z.File[0].Method)
+
+		mimetype, err := z.File[0].Open()
+		assert.NoError(t, err)
+		data, err := io.ReadAll(mimetype)
+		assert.NoError(t, err)
+		assert.Equal(t, ODSContentType, string(data))
+	})
+
+	t.Run("Repeated cells and rows", func(t *testing.T) {
+		content := `<office:document-content xmlns:office="` + odsOfficeNS + `" xmlns:table="` + odsTableNS + `" xmlns:text="` + odsTextNS + `">
+<office:body><office:spreadsheet>
+<table:table table:name="Other"><table:table-row><table:table-cell><text:p>ignored</text:p></table:table-cell></table:table-row></table:table>
+<table:table table:name="Members">
+<table:table-row><table:table-cell><text:p>Name</text:p></table:table-cell><table:table-cell><text:p>Age</text:p></table:table-cell><table:table-cell table:number-columns-repeated="16382"/></table:table-row>
+<table:table-row table:number-rows-repeated="2"><table:table-cell><text:p>A<text:s text:c="2"/>B</text:p></table:table-cell><table:table-cell office:value-type="float" office:value="7"><text:p>7.00</text:p></table:table-cell></table:table-row>
+<table:table-row table:number-rows-repeated="1048573"><table:table-cell table:number-columns-repeated="16384"/></table:table-row>
+</table:table>
+</office:spreadsheet></office:body></office:document-content>`
+
+		rows, err := readODSRows(strings.NewReader(content), newConfig([]Option{WithSheet("Members")}))
+		assert.NoError(t, err)
+		assert.Equal(t, [][]string{{"Name", "Age"}, {"A  B", "7"}, {"A  B", "7"}}, rows)
+
+		_, err = readODSRows(strings.NewReader(content), newConfig([]Option{WithSheet("Missing")}))
+		assert.EqualError(t, err, "sheet 'Missing' does not exist")
+
+		rows, err = readODSRows(strings.NewReader(content), newConfig(nil))
+		assert.NoError(t, err)
+		assert.Equal(t, [][]string{{"ignored"}}, rows)
+
+		_, err = readODSRows(strings.NewReader(content), newConfig([]Option{WithSheet("Members"), WithMaxRows(1)}))
+		assert.ErrorIs(t, err, ErrLimitExceeded)
+		assert.ErrorContains(t, err, "more than 1 data rows")
+
+		_, err = readODSRows(strings.NewReader(content), newConfig([]Option{WithSheet("Members"), WithMaxColumns(1)}))
+		assert.ErrorIs(t, err, ErrLimitExceeded)
+	})
+
+	t.Run("Repeats beyond the sheet", func(t *testing.T) {
+		content := `<office:document-content xmlns:office="` + odsOfficeNS + `" xmlns:table="` + odsTableNS + `" xmlns:text="` + odsTextNS + `">
+<office:body><office:spreadsheet><table:table table:name="Sheet1">
+<table:table-row table:number-rows-repeated="2000000000"><table:table-cell><text:p>x</text:p></table:table-cell></table:table-row>
+</table:table></office:spreadsheet></office:body></office:document-content>`
+
+		_, err := readODSRows(strings.NewReader(content), newConfig(nil))
+		assert.ErrorIs(t, err, ErrLimitExceeded)
+		assert.ErrorContains(t, err, "more than 1048576 rows")
+	})
+
+	t.Run("Upload limits", func(t *testing.T) {
+		_, err := FromODS[member](filename, WithMaxFileSize(100))
+		assert.ErrorIs(t, err, ErrLimitExceeded)
+
+		_, err = FromODS[member](filename, WithMaxRows(1))
+		assert.ErrorIs(t, err, ErrLimitExceeded)
+
+		imported, err := FromODS[member](filename, WithMaxRows(2), WithMaxColumns(4))
+		assert.NoError(t, err)
+		assert.Len(t, imported.Rows, 2)
+	})
+}
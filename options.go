@@ -0,0 +1,129 @@
+package xlsx_utilities
+
+// defaultSheet is the sheet used when no sheet is configured
+const defaultSheet = "Sheet1"
+
+// Option configures how ExcelData is read, converted and written
+type Option func(*config)
+
+// config holds the settings resolved from a list of options
+type config struct {
+	sheet       string
+	selector    *sheetSelector
+	template    string
+	anchor      string
+	skipHeaders bool
+	table       *tableConfig
+	visibility  SheetVisibility
+	password    string
+	styles      *StyleSheet
+	rowStyles   []rowStyler
+	theme       string
+	formulas    bool
+	pivots      []PivotSpec
+	charts      []ChartSpec
+	merges      []MergeSpec
+	outlines    []OutlineSpec
+	namedRanges []namedRange
+	beforeWrite []func(rowIndex int, row []interface{}) error
+	afterRead   []rowHook
+	sanitizers  []Sanitizer
+	skipRow     []func(cells []string) bool
+
+	strictRoundTrip bool
+	headerEvolution bool
+	normalizeText   bool
+	rightToLeft     bool
+	locale          *Locale
+	provenance      bool
+	batchSize       int
+	parallelism     int
+	releaseRows     bool
+	keepPartialRows bool
+	widePolicy      WidePolicy
+	rowPolicy       RowPolicy
+	transposed      bool
+	offset          int
+	limit           int
+	schema          bool
+	rawValues       bool // set internally when reading typed columns from a schema
+	templateHints   bool // set internally when writing an import template
+
+	columnRoles map[string][]string
+	viewer      string
+
+	numberStyles       *numberStyles
+	boolSynonyms       map[string]bool
+	nullValues         map[string]bool
+	trimSpace          bool
+	collapseWhitespace bool
+	normalizeUnicode   bool
+	columnWidths       map[string]float64
+	headerLanguage     string
+	headerTranslations map[string]string
+	groupedHeaders     bool
+	lookups            map[string]map[string]interface{}
+	referenceSheet     string
+	upsert             bool
+	fsync              bool
+	formulaPrefix      string
+	limits             uploadLimits
+	importErrors       []ImportError
+	sampleRows         int
+	strictTypes        bool
+	cellParser         func(text string) interface{}
+	rawStrings         bool
+	nativeTypes        bool
+	fillMergedCells    bool
+	fillDown           []string
+	columnParsers      map[string]CustomTypeParser
+	columnFormatters   map[string]CustomTypeConverter
+
+	continuationColumns   []string
+	continuationSeparator string
+}
+
+// newConfig resolves the given options on top of the defaults
+func newConfig(opts []Option) *config {
+	cfg := &config{
+		sheet:  defaultSheet,
+		anchor: "A1",
+	}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(cfg)
+		}
+	}
+	return cfg
+}
+
+// WithSheet sets the name of the sheet that is read from or written to
+func WithSheet(name string) Option {
+	return func(c *config) {
+		c.sheet = name
+		c.selector = nil
+	}
+}
+
+// WithTemplate writes the export into a copy of an existing workbook instead of a new file,
+// keeping its styling, images and formulas intact
+func WithTemplate(filename string) Option {
+	return func(c *config) {
+		c.template = filename
+	}
+}
+
+// WithAnchor sets the top-left cell (e.g. "B5") where the header row is written
+func WithAnchor(cell string) Option {
+	return func(c *config) {
+		c.anchor = cell
+	}
+}
+
+// WithoutHeaders skips writing the header row, so data starts at the anchor cell.
+// Useful with templates that already contain a styled header.
+func WithoutHeaders() Option {
+	return func(c *config) {
+		c.skipHeaders = true
+	}
+}
@@ -0,0 +1,135 @@
+package xlsx_utilities
+
+import (
+	"fmt"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// OutlineSpec groups data rows or columns of the export under a collapsible outline level,
+// e.g. the order rows below their customer row. Set Header (and optionally ToHeader) to group
+// columns; leave it empty to group the data rows from FromRow to ToRow.
+type OutlineSpec struct {
+	Header    string // header of the first grouped column; empty groups rows
+	ToHeader  string // header of the last grouped column; defaults to Header
+	FromRow   int    // 0-based index of the first grouped data row
+	ToRow     int    // 0-based index of the last grouped data row (inclusive)
+	Level     uint8  // outline level from 1 to 7; defaults to 1
+	Collapsed bool   // hides the grouped rows or columns until the group is expanded
+	// SummaryFirst places the expand button on the row above or the column left of the group,
+	// where the summary row or column is, instead of below or to the right. It applies to the whole sheet.
+	SummaryFirst bool
+}
+
+// WithOutline groups rows or columns of the export. It can be given several times, with
+// increasing levels for groups nested in each other.
+func WithOutline(spec OutlineSpec) Option {
+	return func(c *config) {
+		c.outlines = append(c.outlines, spec)
+	}
+}
+
+// Outline groups rows or columns in every later export of the ExcelData
+func (ed *ExcelData[T]) Outline(spec OutlineSpec) *ExcelData[T] {
+	return ed.WithOptions(WithOutline(spec))
+}
+
+// applyOutlines sets the outline level of the grouped rows and columns
+func applyOutlines(f *excelize.File, layout sheetLayout, cfg *config, headers []string) error {
+	for _, spec := range cfg.outlines {
+		level := spec.Level
+		if level == 0 {
+			level = 1
+		}
+		if level > 7 {
+			return fmt.Errorf("invalid outline level %d", level)
+		}
+
+		cells, err := outlineCells(layout, spec, headers)
+		if err != nil {
+			return err
+		}
+
+		// a logical row is a sheet column in a transposed layout, and the other way around
+		groupColumns := spec.Header != "" != layout.Transposed
+		for _, cell := range cells {
+			col, row, err := excelize.CellNameToCoordinates(cell)
+			if err != nil {
+				return err
+			}
+			if groupColumns {
+				name := intToExcelColumn(col - 1)
+				if err := f.SetColOutlineLevel(layout.Sheet, name, level); err != nil {
+					return err
+				}
+				if spec.Collapsed {
+					err = f.SetColVisible(layout.Sheet, name, false)
+				}
+			} else {
+				if err := f.SetRowOutlineLevel(layout.Sheet, row, level); err != nil {
+					return err
+				}
+				if spec.Collapsed {
+					err = f.SetRowVisible(layout.Sheet, row, false)
+				}
+			}
+			if err != nil {
+				return err
+			}
+		}
+
+		if spec.SummaryFirst {
+			below, right := false, false
+			if err := f.SetSheetProps(layout.Sheet, &excelize.SheetPropsOptions{
+				OutlineSummaryBelow: &below,
+				OutlineSummaryRight: &right,
+			}); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// outlineCells returns one cell on each of the rows or columns grouped by an outline specification
+func outlineCells(layout sheetLayout, spec OutlineSpec, headers []string) ([]string, error) {
+	if spec.Header == "" {
+		if spec.FromRow < 0 || spec.ToRow < spec.FromRow || spec.ToRow >= layout.Rows {
+			return nil, fmt.Errorf("invalid outline rows %d-%d for %d data rows", spec.FromRow, spec.ToRow, layout.Rows)
+		}
+		var cells []string
+		for r := spec.FromRow; r <= spec.ToRow; r++ {
+			cells = append(cells, layout.cell(0, layout.firstDataRow()+r))
+		}
+		return cells, nil
+	}
+
+	first, last := -1, -1
+	toHeader := spec.ToHeader
+	if toHeader == "" {
+		toHeader = spec.Header
+	}
+	for i, h := range headers {
+		if h == spec.Header && first == -1 {
+			first = i
+		}
+		if h == toHeader && last == -1 {
+			last = i
+		}
+	}
+	if first == -1 {
+		return nil, fmt.Errorf("unknown outline column '%s'", spec.Header)
+	}
+	if last == -1 {
+		return nil, fmt.Errorf("unknown outline column '%s'", toHeader)
+	}
+	if last < first {
+		first, last = last, first
+	}
+
+	var cells []string
+	for c := first; c <= last; c++ {
+		cells = append(cells, layout.cell(c, layout.Row))
+	}
+	return cells, nil
+}
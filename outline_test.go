@@ -0,0 +1,84 @@
+package xlsx_utilities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOutline(t *testing.T) {
+	type line struct {
+		Customer string
+		Order    string
+		Amount   int
+		Tax      int
+	}
+
+	data := []line{
+		{Customer: "Alice", Amount: 30},
+		{Order: "A-1", Amount: 10, Tax: 1},
+		{Order: "A-2", Amount: 20, Tax: 2},
+		{Customer: "Bob", Amount: 5},
+		{Order: "B-1", Amount: 5, Tax: 1},
+	}
+
+	t.Run("Rows and columns", func(t *testing.T) {
+		excelData, err := FromStruct(data)
+		assert.NoError(t, err)
+		excelData.Outline(OutlineSpec{FromRow: 1, ToRow: 2, SummaryFirst: true}).
+			Outline(OutlineSpec{FromRow: 4, ToRow: 4, Collapsed: true}).
+			Outline(OutlineSpec{Header: "Amount", ToHeader: "Tax", Level: 2})
+
+		f, err := excelData.ToWorkbook()
+		assert.NoError(t, err)
+		defer f.Close()
+
+		for row, want := range map[int]uint8{1: 0, 2: 0, 3: 1, 4: 1, 5: 0, 6: 1} {
+			level, err := f.GetRowOutlineLevel("Sheet1", row)
+			assert.NoError(t, err)
+			assert.Equal(t, want, level, "row %d", row)
+		}
+		visible, err := f.GetRowVisible("Sheet1", 6)
+		assert.NoError(t, err)
+		assert.False(t, visible)
+
+		for col, want := range map[string]uint8{"B": 0, "C": 2, "D": 2} {
+			level, err := f.GetColOutlineLevel("Sheet1", col)
+			assert.NoError(t, err)
+			assert.Equal(t, want, level, "column %s", col)
+		}
+
+		props, err := f.GetSheetProps("Sheet1")
+		assert.NoError(t, err)
+		assert.False(t, *props.OutlineSummaryBelow)
+	})
+
+	t.Run("Transposed", func(t *testing.T) {
+		excelData, err := FromStruct(data)
+		assert.NoError(t, err)
+
+		f, err := excelData.ToWorkbook(WithTransposed(), WithOutline(OutlineSpec{FromRow: 1, ToRow: 2}))
+		assert.NoError(t, err)
+		defer f.Close()
+
+		for col, want := range map[string]uint8{"B": 0, "C": 1, "D": 1, "E": 0} {
+			level, err := f.GetColOutlineLevel("Sheet1", col)
+			assert.NoError(t, err)
+			assert.Equal(t, want, level, "column %s", col)
+		}
+	})
+
+	t.Run("Invalid specs", func(t *testing.T) {
+		excelData, err := FromStruct(data)
+		assert.NoError(t, err)
+
+		_, err = excelData.ToWorkbook(WithOutline(OutlineSpec{Header: "Unknown"}))
+		assert.ErrorContains(t, err, "unknown outline column 'Unknown'")
+
+		_, err = excelData.ToWorkbook(WithOutline(OutlineSpec{FromRow: 3, ToRow: 9}))
+		assert.ErrorContains(t, err, "invalid outline rows 3-9 for 5 data rows")
+
+		_, err = excelData.ToWorkbook(WithOutline(OutlineSpec{FromRow: 1, ToRow: 1, Level: 8}))
+		assert.ErrorContains(t, err, "invalid outline level 8")
+	})
+}
@@ -0,0 +1,71 @@
+package xlsx_utilities
+
+import (
+	"fmt"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// RowPolicy decides what happens when the exported rows do not fit in a sheet,
+// which holds at most 1,048,576 rows counted from row 1
+type RowPolicy int
+
+const (
+	// RowSplit continues the export on additional sheets named "<sheet> (2)", "<sheet> (3)", ...
+	// each starting with the header row. Only the first sheet gets tables, pivot tables, charts and merged cells.
+	RowSplit RowPolicy = iota
+	// RowError fails the export
+	RowError
+)
+
+// WithRowPolicy sets how exports longer than a sheet are handled; the default is RowSplit
+func WithRowPolicy(policy RowPolicy) Option {
+	return func(c *config) {
+		c.rowPolicy = policy
+	}
+}
+
+// sheetRows returns the number of data rows that fit in a sheet below the given row and header rows
+func sheetRows(row int, headerRows int) int {
+	return excelize.TotalRows - row + 1 - headerRows
+}
+
+// fitRows returns the parts of the data to write to consecutive sheets,
+// the first holding at most first rows and the others at most rest rows
+func (ed *ExcelData[T]) fitRows(cfg *config, first, rest int) ([]*ExcelData[T], error) {
+	if len(ed.Rows) <= first {
+		return []*ExcelData[T]{ed}, nil
+	}
+	if cfg.rowPolicy == RowError {
+		return nil, fmt.Errorf("too many rows (%d, the sheet fits %d)", len(ed.Rows), first)
+	}
+
+	parts := []*ExcelData[T]{ed.Slice(0, first)}
+	return append(parts, ed.Slice(first, -1).Chunks(rest)...), nil
+}
+
+// continuationSheet returns the name of the n-th sheet (0-based) a long or wide export continues on,
+// shortening the sheet name so it stays within the 31 character limit
+func continuationSheet(sheet string, n int) string {
+	if n == 0 {
+		return sheet
+	}
+	suffix := fmt.Sprintf(" (%d)", n+1)
+	if runes := []rune(sheet); len(runes)+len(suffix) > excelize.MaxSheetNameLength {
+		sheet = string(runes[:excelize.MaxSheetNameLength-len(suffix)])
+	}
+	return sheet + suffix
+}
+
+// continuationConfig returns the config of an additional sheet an export continues on, created when missing.
+// Tables, pivot tables, charts, merged cells, outlines and named ranges refer to the data of the first sheet and are left out.
+func continuationConfig(f *excelize.File, cfg *config, sheet string) (*config, error) {
+	if err := ensureSheet(f, sheet); err != nil {
+		return nil, err
+	}
+
+	next := *cfg
+	next.sheet = sheet
+	next.table, next.pivots, next.charts, next.merges, next.outlines, next.namedRanges = nil, nil, nil, nil, nil, nil
+	return &next, nil
+}
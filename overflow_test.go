@@ -0,0 +1,67 @@
+package xlsx_utilities
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRowPolicy(t *testing.T) {
+	data := []person{{Name: "A", Age: 1}, {Name: "B", Age: 2}, {Name: "C", Age: 3}, {Name: "D", Age: 4}, {Name: "E", Age: 5}}
+	excelData, err := FromStruct(data)
+	assert.NoError(t, err)
+
+	// leaves room for a header row and two data rows
+	anchor := WithAnchor("A1048574")
+
+	t.Run("Split onto continuation sheets", func(t *testing.T) {
+		f, err := excelData.ToWorkbook(anchor)
+		assert.NoError(t, err)
+		defer f.Close()
+
+		assert.Equal(t, []string{"Sheet1", "Sheet1 (2)"}, f.GetSheetList())
+
+		last, _ := f.GetCellValue("Sheet1", "A1048576")
+		assert.Equal(t, "B", last)
+
+		rows, err := f.GetRows("Sheet1 (2)")
+		assert.NoError(t, err)
+		assert.Equal(t, [][]string{{"Name", "Age"}, {"C", "3"}, {"D", "4"}, {"E", "5"}}, rows)
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		_, err := excelData.ToWorkbook(anchor, WithRowPolicy(RowError))
+		assert.EqualError(t, err, "too many rows (5, the sheet fits 2)")
+	})
+
+	t.Run("Stream", func(t *testing.T) {
+		w, err := NewStreamWriter[person](nil, anchor, WithBatchSize(2))
+		assert.NoError(t, err)
+		for _, item := range data {
+			assert.NoError(t, w.WriteStruct(item))
+		}
+		assert.NoError(t, w.Close())
+		defer w.File().Close()
+
+		rows, err := w.File().GetRows("Sheet1 (2)")
+		assert.NoError(t, err)
+		assert.Equal(t, [][]string{{"Name", "Age"}, {"C", "3"}, {"D", "4"}, {"E", "5"}}, rows)
+
+		w, err = NewStreamWriter[person](nil, anchor, WithRowPolicy(RowError))
+		assert.NoError(t, err)
+		defer w.File().Close()
+		for _, item := range data {
+			assert.NoError(t, w.WriteStruct(item))
+		}
+		assert.EqualError(t, w.Close(), "too many rows (the sheet fits 2)")
+	})
+
+	t.Run("Continuation sheet names", func(t *testing.T) {
+		assert.Equal(t, "Sheet1", continuationSheet("Sheet1", 0))
+		assert.Equal(t, "Sheet1 (3)", continuationSheet("Sheet1", 2))
+
+		name := continuationSheet(strings.Repeat("x", 31), 1)
+		assert.Equal(t, strings.Repeat("x", 27)+" (2)", name)
+	})
+}
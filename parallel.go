@@ -0,0 +1,46 @@
+package xlsx_utilities
+
+import "sync"
+
+// WithParallelism makes ToStruct convert rows on n goroutines. Results and errors keep the row order.
+// Hooks registered with WithAfterReadRow then run concurrently and must be safe for concurrent use.
+func WithParallelism(n int) Option {
+	return func(c *config) {
+		c.parallelism = n
+	}
+}
+
+// convertParallel converts contiguous ranges of rows on the given number of workers and returns the outcomes in row order
+func (conv *rowConverter[T]) convertParallel(rows [][]interface{}, workers int) []convertedRow[T] {
+	if len(rows) == 0 {
+		return nil
+	}
+	if workers > len(rows) {
+		workers = len(rows)
+	}
+
+	converted := make([]convertedRow[T], len(rows))
+	size := (len(rows) + workers - 1) / workers
+
+	var wg sync.WaitGroup
+	for start := 0; start < len(rows); start += size {
+		end := start + size
+		if end > len(rows) {
+			end = len(rows)
+		}
+
+		wg.Add(1)
+		go func(start, end int) {
+			defer wg.Done()
+			for i := start; i < end; i++ {
+				converted[i] = conv.convertRow(i, rows[i])
+				if conv.cfg.releaseRows {
+					rows[i] = nil
+				}
+			}
+		}(start, end)
+	}
+	wg.Wait()
+
+	return converted
+}
@@ -0,0 +1,40 @@
+package xlsx_utilities
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParallelism(t *testing.T) {
+	excelData := NewExcelData[person]([]string{"Name", "Age"})
+	for i := 0; i < 1000; i++ {
+		excelData.AddRow([]interface{}{fmt.Sprintf("P%d", i), i})
+	}
+
+	rejectTens := WithAfterReadRow(func(rowIndex int, item *person) error {
+		if item.Age%10 == 0 {
+			return fmt.Errorf("age %d rejected", item.Age)
+		}
+		return nil
+	})
+
+	sequential := excelData.Clone().WithOptions(rejectTens).ToStruct()
+	assert.Len(t, sequential.Data, 900)
+	assert.Len(t, sequential.Errors, 100)
+
+	for _, n := range []int{2, 7, 32, 5000} {
+		t.Run(fmt.Sprintf("%d workers", n), func(t *testing.T) {
+			parallel := excelData.Clone().WithOptions(rejectTens, WithParallelism(n)).ToStruct()
+			assert.Equal(t, sequential.Data, parallel.Data)
+			assert.Equal(t, sequential.Errors, parallel.Errors)
+		})
+	}
+
+	t.Run("No rows", func(t *testing.T) {
+		empty := NewExcelData[person]([]string{"Name", "Age"}).WithOptions(WithParallelism(4)).ToStruct()
+		assert.Empty(t, empty.Data)
+		assert.Empty(t, empty.Errors)
+	})
+}
@@ -0,0 +1,49 @@
+package xlsx_utilities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKeepPartialRows(t *testing.T) {
+	type employee struct {
+		Name  string
+		Hired time.Time
+	}
+
+	newData := func(opts ...Option) *ExcelData[employee] {
+		excelData := NewExcelData[employee]([]string{"Name", "Hired"})
+		excelData.AddRow([]interface{}{"Alice", "2024-03-01"})
+		excelData.AddRow([]interface{}{"Bob", "soon"})
+		excelData.AddRow([]interface{}{"Carol", "never"})
+		return excelData.WithOptions(opts...)
+	}
+
+	t.Run("Discarded by default", func(t *testing.T) {
+		result := newData().ToStruct()
+		assert.Len(t, result.Data, 1)
+		assert.Len(t, result.Errors, 2)
+	})
+
+	t.Run("Kept with zero fields", func(t *testing.T) {
+		rejectCarol := WithAfterReadRow(func(rowIndex int, item *employee) error {
+			if item.Name == "Carol" {
+				return assert.AnError
+			}
+			return nil
+		})
+
+		result := newData(WithKeepPartialRows(), rejectCarol).ToStruct()
+		assert.Equal(t, []employee{
+			{Name: "Alice", Hired: time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)},
+			{Name: "Bob"},
+		}, result.Data)
+
+		assert.Len(t, result.Errors, 3)
+		assert.Equal(t, "Hired", result.Errors[0].Header)
+		assert.Equal(t, "Hired", result.Errors[1].Header)
+		assert.Equal(t, assert.AnError, result.Errors[2].Err)
+	})
+}
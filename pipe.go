@@ -0,0 +1,90 @@
+package xlsx_utilities
+
+import "fmt"
+
+// frame is the untyped dataset the steps of a pipe work on
+type frame = ExcelData[struct{}]
+
+// Step is one transformation applied by Pipe
+type Step struct {
+	name  string
+	apply func(*frame) (*frame, error)
+}
+
+// Pipe applies the steps in order, e.g. ed.Pipe(Filter(...), Sort(...), Limit(10)),
+// stopping at the first failing step. The result may share row storage with ed.
+func (ed *ExcelData[T]) Pipe(steps ...Step) (*ExcelData[T], error) {
+	current := &frame{Headers: ed.Headers, Rows: ed.Rows, Provenance: ed.Provenance, sheet: ed.sheet, lines: ed.lines}
+	for i, step := range steps {
+		next, err := step.apply(current)
+		if err != nil {
+			return nil, fmt.Errorf("step %d (%s): %w", i+1, step.name, err)
+		}
+		current = next
+	}
+
+	result := ed.view(current.Rows)
+	result.Headers = current.Headers
+	result.Provenance = current.Provenance
+	result.lines = current.lines
+	return result, nil
+}
+
+// Filter keeps the rows for which keep returns true
+func Filter(keep func(row Row) bool) Step {
+	return Step{name: "filter", apply: func(f *frame) (*frame, error) {
+		return f.Filter(keep), nil
+	}}
+}
+
+// Map replaces the values of each row by those returned by fn
+func Map(fn func(row Row) ([]interface{}, error)) Step {
+	return Step{name: "map", apply: func(f *frame) (*frame, error) {
+		return f.Map(fn)
+	}}
+}
+
+// Sort orders the rows by less, keeping the order of rows comparing equal
+func Sort(less func(a, b Row) bool) Step {
+	return Step{name: "sort", apply: func(f *frame) (*frame, error) {
+		return f.Sort(less), nil
+	}}
+}
+
+// Limit keeps the first n rows
+func Limit(n int) Step {
+	return Step{name: "limit", apply: func(f *frame) (*frame, error) {
+		if n < 0 {
+			return nil, fmt.Errorf("negative limit %d", n)
+		}
+		return f.Head(n), nil
+	}}
+}
+
+// Select keeps only the given columns, in the given order
+func Select(headers ...string) Step {
+	return Step{name: "select", apply: func(f *frame) (*frame, error) {
+		return f.SelectColumns(headers...)
+	}}
+}
+
+// Drop removes the given columns
+func Drop(headers ...string) Step {
+	return Step{name: "drop", apply: func(f *frame) (*frame, error) {
+		return f.DropColumns(headers...)
+	}}
+}
+
+// GroupBy replaces the rows by one aggregated row per distinct combination of the key columns
+func GroupBy(keys []string, aggs map[string]Agg) Step {
+	return Step{name: "group by", apply: func(f *frame) (*frame, error) {
+		return f.GroupBy(keys, aggs)
+	}}
+}
+
+// CrossTab reshapes the rows into a cross tabulation of the values column by the rows and columns columns
+func CrossTab(rows, columns, values string, fn AggFunc) Step {
+	return Step{name: "cross tab", apply: func(f *frame) (*frame, error) {
+		return f.CrossTab(rows, columns, values, fn)
+	}}
+}
@@ -0,0 +1,64 @@
+package xlsx_utilities
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPipe(t *testing.T) {
+	excelData, err := FromStruct([]order{
+		{ID: 1, Status: "paid", Total: 120},
+		{ID: 2, Status: "cancelled", Total: 40},
+		{ID: 3, Status: "paid", Total: 15.5},
+		{ID: 4, Status: "open", Total: 99},
+	})
+	assert.NoError(t, err)
+
+	byTotal := func(a, b Row) bool {
+		x, _ := a.Float("Total")
+		y, _ := b.Float("Total")
+		return x > y
+	}
+
+	t.Run("Chain", func(t *testing.T) {
+		result, err := excelData.Pipe(
+			Filter(func(row Row) bool { return row.Text("Status") != "cancelled" }),
+			Map(func(row Row) ([]interface{}, error) {
+				values := append([]interface{}(nil), row.Values...)
+				values[1] = strings.ToUpper(row.Text("Status"))
+				return values, nil
+			}),
+			Sort(byTotal),
+			Limit(2),
+		)
+		assert.NoError(t, err)
+		assert.Equal(t, []order{{ID: 1, Status: "PAID", Total: 120}, {ID: 4, Status: "OPEN", Total: 99}}, result.ToStruct().Data)
+		assert.Equal(t, "paid", excelData.Rows[0][1])
+	})
+
+	t.Run("Columns", func(t *testing.T) {
+		result, err := excelData.Pipe(Drop("Status"), Select("Total", "ID"), Limit(1))
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"Total", "ID"}, result.Headers)
+		assert.Equal(t, [][]interface{}{{120.0, 1}}, result.Rows)
+	})
+
+	t.Run("Errors name the step", func(t *testing.T) {
+		_, err := excelData.Pipe(Sort(byTotal), Map(func(row Row) ([]interface{}, error) {
+			if row.Text("Status") == "cancelled" {
+				return nil, fmt.Errorf("cancelled order")
+			}
+			return row.Values, nil
+		}))
+		assert.EqualError(t, err, "step 2 (map): row 2: cancelled order")
+
+		_, err = excelData.Pipe(Limit(-1))
+		assert.EqualError(t, err, "step 1 (limit): negative limit -1")
+
+		_, err = excelData.Pipe(Select("Email"))
+		assert.EqualError(t, err, "step 1 (select): unknown columns: Email")
+	})
+}
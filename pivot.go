@@ -0,0 +1,143 @@
+package xlsx_utilities
+
+import (
+	"fmt"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// PivotValue describes an aggregated value field of a pivot table
+type PivotValue struct {
+	Header string
+	// Aggregation is one of "Sum", "Count", "Average", "Max", "Min", "Product"; defaults to "Sum"
+	Aggregation string
+	// Name is the caption of the value field; defaults to "<Aggregation> of <Header>"
+	Name string
+}
+
+// PivotSpec describes a pivot table built from the exported data, configured by header names
+type PivotSpec struct {
+	Name    string
+	Sheet   string // sheet holding the pivot table, created when missing; defaults to "Pivot"
+	Cell    string // top-left cell of the pivot table; defaults to "A1"
+	Rows    []string
+	Columns []string
+	Filters []string
+	Values  []PivotValue
+	Style   string
+}
+
+// WithPivotTable adds a pivot table over the exported data range. It can be given several times.
+func WithPivotTable(spec PivotSpec) Option {
+	return func(c *config) {
+		c.pivots = append(c.pivots, spec)
+	}
+}
+
+// applyPivotTables adds the configured pivot tables
+func applyPivotTables(f *excelize.File, layout sheetLayout, cfg *config, headers []string) error {
+	for _, spec := range cfg.pivots {
+		if err := addPivotTable(f, layout, spec, headers); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func addPivotTable(f *excelize.File, layout sheetLayout, spec PivotSpec, headers []string) error {
+	if !layout.HeaderRow {
+		return fmt.Errorf("pivot table requires a header row")
+	}
+
+	if spec.Sheet == "" {
+		spec.Sheet = "Pivot"
+	}
+	if spec.Cell == "" {
+		spec.Cell = "A1"
+	}
+
+	known := make(map[string]bool, len(headers))
+	for _, header := range headers {
+		known[header] = true
+	}
+
+	fields := func(names []string) ([]excelize.PivotTableField, error) {
+		var out []excelize.PivotTableField
+		for _, name := range names {
+			if !known[name] {
+				return nil, fmt.Errorf("unknown pivot field '%s'", name)
+			}
+			out = append(out, excelize.PivotTableField{Data: name})
+		}
+		return out, nil
+	}
+
+	rows, err := fields(spec.Rows)
+	if err != nil {
+		return err
+	}
+	columns, err := fields(spec.Columns)
+	if err != nil {
+		return err
+	}
+	filters, err := fields(spec.Filters)
+	if err != nil {
+		return err
+	}
+
+	if len(spec.Values) == 0 {
+		return fmt.Errorf("pivot table requires at least one value field")
+	}
+
+	var values []excelize.PivotTableField
+	for _, value := range spec.Values {
+		if !known[value.Header] {
+			return fmt.Errorf("unknown pivot field '%s'", value.Header)
+		}
+		if value.Aggregation == "" {
+			value.Aggregation = "Sum"
+		}
+		if value.Name == "" {
+			value.Name = value.Aggregation + " of " + value.Header
+		}
+		values = append(values, excelize.PivotTableField{Data: value.Header, Name: value.Name, Subtotal: value.Aggregation})
+	}
+
+	index, err := f.GetSheetIndex(spec.Sheet)
+	if err != nil {
+		return err
+	}
+	if index == -1 {
+		if _, err := f.NewSheet(spec.Sheet); err != nil {
+			return err
+		}
+	}
+
+	col, row, err := excelize.CellNameToCoordinates(spec.Cell)
+	if err != nil {
+		return fmt.Errorf("invalid pivot cell '%s': %w", spec.Cell, err)
+	}
+
+	// Excel resizes the pivot table on refresh, the range only has to anchor it
+	width := len(spec.Rows) + len(spec.Values)*(len(spec.Columns)+1)
+	height := layout.Rows + len(spec.Columns) + 3
+
+	// excelize expects unquoted sheet names in pivot ranges
+	last := layout.lastRow()
+
+	return f.AddPivotTable(&excelize.PivotTableOptions{
+		DataRange:           absoluteRange(layout.Sheet, layout.Col, layout.Row, layout.Col+layout.Cols-1, last),
+		PivotTableRange:     absoluteRange(spec.Sheet, col, row, col+width-1, row+height-1),
+		Name:                spec.Name,
+		Rows:                rows,
+		Columns:             columns,
+		Filter:              filters,
+		Data:                values,
+		RowGrandTotals:      true,
+		ColGrandTotals:      true,
+		ShowDrill:           true,
+		ShowRowHeaders:      true,
+		ShowColHeaders:      true,
+		PivotTableStyleName: spec.Style,
+	})
+}
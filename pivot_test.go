@@ -0,0 +1,58 @@
+package xlsx_utilities
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/xuri/excelize/v2"
+)
+
+func TestPivotTable(t *testing.T) {
+	type sale struct {
+		Region string
+		Month  string
+		Amount float64
+	}
+
+	data := []sale{
+		{Region: "North", Month: "Jan", Amount: 100},
+		{Region: "South", Month: "Jan", Amount: 80},
+		{Region: "North", Month: "Feb", Amount: 120},
+	}
+	excelData, err := FromStruct(data)
+	assert.NoError(t, err)
+
+	t.Run("Pivot on new sheet", func(t *testing.T) {
+		filename := "test_pivot.xlsx"
+		defer os.Remove(filename)
+
+		err := excelData.Save(filename, WithSheet("Sales Data"), WithPivotTable(PivotSpec{
+			Name:    "SalesPivot",
+			Rows:    []string{"Region"},
+			Columns: []string{"Month"},
+			Values:  []PivotValue{{Header: "Amount"}},
+		}))
+		assert.NoError(t, err)
+
+		f, err := excelize.OpenFile(filename)
+		assert.NoError(t, err)
+		defer f.Close()
+		assert.Equal(t, []string{"Sales Data", "Pivot"}, f.GetSheetList())
+
+		pivots, err := f.GetPivotTables("Pivot")
+		assert.NoError(t, err)
+		assert.Len(t, pivots, 1)
+		assert.Contains(t, pivots[0].DataRange, "A1:C4")
+		assert.Equal(t, "Sum of Amount", pivots[0].Data[0].Name)
+	})
+
+	t.Run("Unknown field", func(t *testing.T) {
+		_, err := excelData.ToWorkbook(WithPivotTable(PivotSpec{
+			Rows:   []string{"Country"},
+			Values: []PivotValue{{Header: "Amount"}},
+		}))
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "unknown pivot field 'Country'")
+	})
+}
@@ -0,0 +1,69 @@
+package xlsx_utilities
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// previewCellWidth is the number of characters after which Preview cuts cell text
+const previewCellWidth = 30
+
+// Preview returns the headers and the first n rows as an aligned text table for logs and debugging,
+// followed by the number of rows left out. Cells longer than 30 characters are cut and line breaks
+// and other runs of white space are shown as a single space; a negative n shows every row.
+func (ed *ExcelData[T]) Preview(n int) string {
+	rows := ed.Head(n).Rows
+	if n < 0 {
+		rows = ed.Rows
+	}
+
+	table := make([][]string, 0, len(rows)+1)
+	table = append(table, ed.Headers)
+	for _, row := range rows {
+		cells := make([]string, len(ed.Headers))
+		for i := range ed.Headers {
+			cells[i] = previewText(cellAt(row, i))
+		}
+		table = append(table, cells)
+	}
+
+	widths := make([]int, len(ed.Headers))
+	for _, cells := range table {
+		for i, cell := range cells {
+			widths[i] = max(widths[i], utf8.RuneCountInString(cell))
+		}
+	}
+
+	var b strings.Builder
+	separator := func() {
+		for _, width := range widths {
+			b.WriteString("+" + strings.Repeat("-", width+2))
+		}
+		b.WriteString("+\n")
+	}
+
+	separator()
+	for r, cells := range table {
+		for i, cell := range cells {
+			b.WriteString("| " + cell + strings.Repeat(" ", widths[i]-utf8.RuneCountInString(cell)) + " ")
+		}
+		b.WriteString("|\n")
+		if r == 0 || r == len(table)-1 {
+			separator()
+		}
+	}
+	if rest := len(ed.Rows) - len(rows); rest > 0 {
+		fmt.Fprintf(&b, "(%d more rows)\n", rest)
+	}
+	return b.String()
+}
+
+// previewText returns the text of a cell on one line, cut to previewCellWidth characters
+func previewText(value interface{}) string {
+	text := strings.Join(strings.Fields(cellText(value)), " ")
+	if runes := []rune(text); len(runes) > previewCellWidth {
+		text = string(runes[:previewCellWidth-3]) + "..."
+	}
+	return text
+}
@@ -0,0 +1,27 @@
+package xlsx_utilities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPreview(t *testing.T) {
+	excelData, err := FromStruct([]person{
+		{Name: "Alice", Age: 30},
+		{Name: "Bob\nthe Builder", Age: 7},
+		{Name: "Zoë with a name far longer than thirty", Age: 41},
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, ""+
+		"+-----------------+-----+\n"+
+		"| Name            | Age |\n"+
+		"+-----------------+-----+\n"+
+		"| Alice           | 30  |\n"+
+		"| Bob the Builder | 7   |\n"+
+		"+-----------------+-----+\n"+
+		"(1 more rows)\n", excelData.Preview(2))
+
+	assert.Contains(t, excelData.Preview(-1), "| Zoë with a name far longer ... | 41  |\n")
+}
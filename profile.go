@@ -0,0 +1,103 @@
+package xlsx_utilities
+
+import (
+	"reflect"
+	"time"
+)
+
+// ColumnProfile summarizes the values of one column, e.g. to find why an import fails or to feed a data-quality dashboard
+type ColumnProfile struct {
+	Header string
+	// Types counts the non-empty values by inferred type: "int", "float", "bool", "time", "string",
+	// or the Go type name of other values
+	Types map[string]int
+	// Nulls counts the nil and empty text values, short rows included
+	Nulls int
+	// Distinct counts the different non-empty values, compared by their text
+	Distinct int
+	// Min and Max are the smallest and largest non-empty values, compared as numbers when all of
+	// them are numeric, as times when all of them are times and by their text otherwise
+	Min, Max interface{}
+}
+
+// Profile returns statistics about each column, in header order
+func (ed *ExcelData[T]) Profile() []ColumnProfile {
+	profiles := make([]ColumnProfile, len(ed.Headers))
+	for col, header := range ed.Headers {
+		profiles[col] = profileColumn(header, ed.Rows, col)
+	}
+	return profiles
+}
+
+// profileColumn computes the ColumnProfile of the 0-based column
+func profileColumn(header string, rows [][]interface{}, col int) ColumnProfile {
+	p := ColumnProfile{Header: header, Types: make(map[string]int)}
+
+	var values []interface{}
+	distinct := make(map[string]bool)
+	for _, row := range rows {
+		value := cellAt(row, col)
+		if isEmptyCell(value) {
+			p.Nulls++
+			continue
+		}
+		p.Types[valueKind(value)]++
+		distinct[cellText(value)] = true
+		values = append(values, value)
+	}
+	p.Distinct = len(distinct)
+
+	less := func(a, b interface{}) bool { return cellText(a) < cellText(b) }
+	if len(p.Types) == 1 && p.Types["time"] > 0 {
+		less = func(a, b interface{}) bool { return a.(time.Time).Before(b.(time.Time)) }
+	} else if p.Types["int"]+p.Types["float"] == len(values) {
+		less = func(a, b interface{}) bool {
+			x, _ := numericValue(a)
+			y, _ := numericValue(b)
+			return x < y
+		}
+	}
+	for _, value := range values {
+		if p.Min == nil || less(value, p.Min) {
+			p.Min = value
+		}
+		if p.Max == nil || less(p.Max, value) {
+			p.Max = value
+		}
+	}
+	return p
+}
+
+// valueKind names the inferred type of a non-empty cell value
+func valueKind(value interface{}) string {
+	if _, ok := value.(time.Time); ok {
+		return "time"
+	}
+	switch v := reflect.ValueOf(value); v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return "int"
+	case reflect.Float32, reflect.Float64:
+		return "float"
+	case reflect.Bool:
+		return "bool"
+	case reflect.String:
+		return "string"
+	default:
+		return v.Type().String()
+	}
+}
+
+// numericValue returns the value of an integer or floating point cell as a float64
+func numericValue(value interface{}) (float64, bool) {
+	switch v := reflect.ValueOf(value); v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(v.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(v.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return v.Float(), true
+	default:
+		return 0, false
+	}
+}
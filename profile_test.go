@@ -0,0 +1,38 @@
+package xlsx_utilities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProfile(t *testing.T) {
+	excelData := NewExcelData[struct{}]([]string{"Name", "Score", "Joined"})
+	excelData.Rows = [][]interface{}{
+		{"Bob", 12, time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)},
+		{"Alice", 9.5, time.Date(2023, 7, 1, 0, 0, 0, 0, time.UTC)},
+		{"Alice", "", nil},
+		{"Carol", 100},
+	}
+
+	profiles := excelData.Profile()
+	assert.Len(t, profiles, 3)
+
+	assert.Equal(t, ColumnProfile{
+		Header: "Name", Types: map[string]int{"string": 4}, Distinct: 3, Min: "Alice", Max: "Carol",
+	}, profiles[0])
+	assert.Equal(t, ColumnProfile{
+		Header: "Score", Types: map[string]int{"int": 2, "float": 1}, Nulls: 1, Distinct: 3, Min: 9.5, Max: 100,
+	}, profiles[1])
+	assert.Equal(t, 2, profiles[2].Nulls)
+	assert.Equal(t, time.Date(2023, 7, 1, 0, 0, 0, 0, time.UTC), profiles[2].Min)
+
+	t.Run("Mixed values compare as text", func(t *testing.T) {
+		mixed := NewExcelData[struct{}]([]string{"Code"})
+		mixed.Rows = [][]interface{}{{"B7"}, {42}, {true}}
+		profile := mixed.Profile()[0]
+		assert.Equal(t, 42, profile.Min)
+		assert.Equal(t, true, profile.Max)
+	})
+}
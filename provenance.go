@@ -0,0 +1,198 @@
+package xlsx_utilities
+
+import (
+	"fmt"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// Provenance records where the value of a cell came from
+type Provenance int
+
+const (
+	// ProvenanceUser marks a value provided by the user; it is the default for untracked cells
+	ProvenanceUser Provenance = iota
+	// ProvenanceDefaulted marks a filler value used because no value was provided
+	ProvenanceDefaulted
+	// ProvenanceComputed marks a value derived from other values
+	ProvenanceComputed
+)
+
+// provenanceNames are the codes written to the provenance sheet
+var provenanceNames = map[Provenance]string{
+	ProvenanceUser:      "user",
+	ProvenanceDefaulted: "default",
+	ProvenanceComputed:  "computed",
+}
+
+// String returns the code of the provenance as written to the provenance sheet
+func (p Provenance) String() string {
+	if name, ok := provenanceNames[p]; ok {
+		return name
+	}
+	return fmt.Sprintf("Provenance(%d)", int(p))
+}
+
+// parseProvenance parses a provenance code; blank cells are user-provided
+func parseProvenance(code string) (Provenance, error) {
+	if code == "" {
+		return ProvenanceUser, nil
+	}
+	for p, name := range provenanceNames {
+		if name == code {
+			return p, nil
+		}
+	}
+	return ProvenanceUser, fmt.Errorf("unknown provenance '%s'", code)
+}
+
+// WithProvenance records the provenance of every exported cell in a hidden sheet next to the data
+// sheet, named "<sheet> Provenance", and reads it back into ExcelData.Provenance on import
+func WithProvenance() Option {
+	return func(c *config) {
+		c.provenance = true
+	}
+}
+
+// provenanceSheet returns the name of the hidden sheet holding the provenance of the given sheet
+func provenanceSheet(sheet string) string {
+	name := sheet + " Provenance"
+	if runes := []rune(name); len(runes) > 31 {
+		name = string(runes[:31])
+	}
+	return name
+}
+
+// SetProvenance records the provenance of the cell at the 0-based row index and header
+func (ed *ExcelData[T]) SetProvenance(rowIndex int, header string, p Provenance) error {
+	if rowIndex < 0 || rowIndex >= len(ed.Rows) {
+		return fmt.Errorf("row %d out of range", rowIndex)
+	}
+
+	col := -1
+	for i, h := range ed.Headers {
+		if h == header {
+			col = i
+			break
+		}
+	}
+	if col == -1 {
+		return fmt.Errorf("unknown column '%s'", header)
+	}
+
+	for len(ed.Provenance) <= rowIndex {
+		ed.Provenance = append(ed.Provenance, nil)
+	}
+	for len(ed.Provenance[rowIndex]) <= col {
+		ed.Provenance[rowIndex] = append(ed.Provenance[rowIndex], ProvenanceUser)
+	}
+	ed.Provenance[rowIndex][col] = p
+	return nil
+}
+
+// ProvenanceOf returns the provenance of the cell at the 0-based row index and header.
+// Untracked cells are reported as ProvenanceUser.
+func (ed *ExcelData[T]) ProvenanceOf(rowIndex int, header string) Provenance {
+	for col, h := range ed.Headers {
+		if h == header {
+			return ed.provenanceAt(rowIndex, col)
+		}
+	}
+	return ProvenanceUser
+}
+
+// provenanceAt returns the provenance of the cell at the 0-based row and column
+func (ed *ExcelData[T]) provenanceAt(row, col int) Provenance {
+	if row < 0 || row >= len(ed.Provenance) || col < 0 || col >= len(ed.Provenance[row]) {
+		return ProvenanceUser
+	}
+	return ed.Provenance[row][col]
+}
+
+// rowProvenance returns the provenance of the row, or nil when none of its cells is tracked
+func (ed *ExcelData[T]) rowProvenance(row int) []Provenance {
+	if row < 0 || row >= len(ed.Provenance) {
+		return nil
+	}
+	return ed.Provenance[row]
+}
+
+// sliceProvenance returns the provenance of the rows in [offset, end)
+func (ed *ExcelData[T]) sliceProvenance(offset, end int) [][]Provenance {
+	if offset >= len(ed.Provenance) {
+		return nil
+	}
+	if end > len(ed.Provenance) {
+		end = len(ed.Provenance)
+	}
+	return ed.Provenance[offset:end:end]
+}
+
+// applyProvenance writes the provenance codes to the hidden provenance sheet, at the same cells as the data
+func (ed *ExcelData[T]) applyProvenance(f *excelize.File, layout sheetLayout, cfg *config) error {
+	if !cfg.provenance {
+		return nil
+	}
+
+	sheet := provenanceSheet(layout.Sheet)
+	if err := ensureSheet(f, sheet); err != nil {
+		return err
+	}
+
+	target := layout
+	target.Sheet = sheet
+
+	if target.HeaderRow {
+		for i, header := range ed.Headers {
+			if err := f.SetCellValue(sheet, target.cell(i, target.Row), header); err != nil {
+				return err
+			}
+		}
+	}
+
+	for rowIndex, row := range ed.Rows {
+		for i := range row {
+			code := ed.provenanceAt(rowIndex, i).String()
+			if err := f.SetCellValue(sheet, target.cell(i, target.firstDataRow()+rowIndex), code); err != nil {
+				return err
+			}
+		}
+	}
+
+	return f.SetSheetVisible(sheet, false, true)
+}
+
+// readProvenance reads the provenance sheet of the configured sheet into ed.Provenance, when present
+func (ed *ExcelData[T]) readProvenance(f *excelize.File, cfg *config) error {
+	sheet := provenanceSheet(cfg.sheet)
+	if index, err := f.GetSheetIndex(sheet); err != nil || index == -1 {
+		return err
+	}
+
+	rows, err := f.GetRows(sheet)
+	if err != nil {
+		return err
+	}
+	if cfg.transposed {
+		rows = transposeRows(rows)
+	}
+
+	ed.Provenance = make([][]Provenance, len(ed.Rows))
+	for rowIndex := range ed.Rows {
+		line := sheetLine(ed.lines, rowIndex, cfg)
+		if line > len(rows) {
+			break
+		}
+		codes := rows[line-1]
+		ed.Provenance[rowIndex] = make([]Provenance, len(codes))
+		for col, code := range codes {
+			p, err := parseProvenance(code)
+			if err != nil {
+				return fmt.Errorf("row %d: %w", line, err)
+			}
+			ed.Provenance[rowIndex][col] = p
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,65 @@
+package xlsx_utilities
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProvenance(t *testing.T) {
+	filename := "test_provenance.xlsx"
+	defer os.Remove(filename)
+
+	data := []person{{Name: "Alice", Age: 30}, {Name: "Bob"}}
+	excelData, err := FromStruct(data)
+	assert.NoError(t, err)
+
+	assert.NoError(t, excelData.SetProvenance(1, "Age", ProvenanceDefaulted))
+	assert.Error(t, excelData.SetProvenance(2, "Age", ProvenanceDefaulted))
+	assert.Error(t, excelData.SetProvenance(0, "Unknown", ProvenanceDefaulted))
+
+	assert.Equal(t, ProvenanceDefaulted, excelData.Tail(1).ProvenanceOf(0, "Age"))
+	assert.Equal(t, ProvenanceUser, excelData.Head(1).ProvenanceOf(0, "Age"))
+
+	assert.NoError(t, excelData.Save(filename, WithProvenance()))
+
+	t.Run("Hidden provenance sheet", func(t *testing.T) {
+		imported, err := FromExcel[person](filename)
+		assert.NoError(t, err)
+		assert.Nil(t, imported.Provenance)
+
+		withSheet, err := FromExcel[person](filename, WithSheet(provenanceSheet("Sheet1")))
+		assert.NoError(t, err)
+		assert.Equal(t, []interface{}{"user", "default"}, withSheet.Rows[1])
+	})
+
+	t.Run("Read back on import", func(t *testing.T) {
+		imported, err := FromExcel[person](filename, WithProvenance())
+		assert.NoError(t, err)
+		assert.Equal(t, ProvenanceUser, imported.ProvenanceOf(0, "Age"))
+		assert.Equal(t, ProvenanceDefaulted, imported.ProvenanceOf(1, "Age"))
+		assert.Equal(t, ProvenanceUser, imported.ProvenanceOf(1, "Name"))
+	})
+
+	t.Run("Skipped rows", func(t *testing.T) {
+		skipAlice := WithSkipRow(func(cells []string) bool {
+			return len(cells) > 0 && cells[0] == "Alice"
+		})
+		imported, err := FromExcel[person](filename, WithProvenance(), skipAlice)
+		assert.NoError(t, err)
+		assert.Equal(t, "Bob", imported.Rows[0][0])
+		assert.Equal(t, ProvenanceDefaulted, imported.ProvenanceOf(0, "Age"))
+	})
+
+	t.Run("Grouped headers", func(t *testing.T) {
+		grouped := "test_provenance_grouped.xlsx"
+		defer os.Remove(grouped)
+		assert.NoError(t, excelData.Save(grouped, WithProvenance(), WithGroupedHeaders()))
+
+		imported, err := FromExcel[person](grouped, WithProvenance(), WithGroupedHeaders())
+		assert.NoError(t, err)
+		assert.Equal(t, ProvenanceUser, imported.ProvenanceOf(0, "Age"))
+		assert.Equal(t, ProvenanceDefaulted, imported.ProvenanceOf(1, "Age"))
+	})
+}
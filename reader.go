@@ -0,0 +1,285 @@
+package xlsx_utilities
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// WithFormulas reads formula cells as their formula text (e.g. "=SUM(A1:A3)")
+// instead of the cached computed value
+func WithFormulas() Option {
+	return func(c *config) {
+		c.formulas = true
+	}
+}
+
+// WithContinuationRows merges continuation rows into the previous record on import.
+// A row is a continuation when its only non-blank cells are in the given columns;
+// their text is appended to the previous row's cells using the separator.
+func WithContinuationRows(separator string, columns ...string) Option {
+	return func(c *config) {
+		c.continuationSeparator = separator
+		c.continuationColumns = columns
+	}
+}
+
+// WithSkipRow drops the data rows for which skip returns true on import, before they are converted.
+// The predicate is given the cell text of the row, which may be shorter than the header row.
+func WithSkipRow(skip func(cells []string) bool) Option {
+	return func(c *config) {
+		c.skipRow = append(c.skipRow, skip)
+	}
+}
+
+// WithSkipBlankRows drops data rows whose cells are all empty or white space on import,
+// such as the trailing rows left behind by deleting data in Excel
+func WithSkipBlankRows() Option {
+	return WithSkipRow(isBlankRow)
+}
+
+// isBlankRow reports whether every cell of the row is empty or white space
+func isBlankRow(cells []string) bool {
+	for _, cell := range cells {
+		if strings.TrimSpace(cell) != "" {
+			return false
+		}
+	}
+	return true
+}
+
+// skipRows returns the header row and the data rows not matched by any of the predicates, with their sheet lines
+func skipRows(rows [][]string, lines []int, skip []func(cells []string) bool) ([][]string, []int) {
+	kept, keptLines := [][]string{rows[0]}, []int{lines[0]}
+	for i, row := range rows[1:] {
+		skipped := false
+		for _, fn := range skip {
+			if fn(row) {
+				skipped = true
+				break
+			}
+		}
+		if !skipped {
+			kept = append(kept, row)
+			keptLines = append(keptLines, lines[i+1])
+		}
+	}
+	return kept, keptLines
+}
+
+// sheetLines returns the 1-based sheet line, a row or with transposed sheets a column, of each of n rows
+// read, headers first. With grouped headers the first row joins the two header lines.
+func sheetLines(n int, cfg *config) []int {
+	lines := make([]int, n)
+	for i := range lines {
+		lines[i] = i + 1
+		if i > 0 {
+			lines[i] += cfg.headerRows() - 1
+		}
+	}
+	return lines
+}
+
+// readRows reads the cell contents of the configured sheet with the sheet line of each row,
+// and with WithNativeTypes the typed cells of its data rows
+func readRows(f *excelize.File, cfg *config) ([][]string, []int, nativeRows, error) {
+	var rows [][]string
+	var err error
+	if cfg.limit > 0 && !cfg.transposed && len(cfg.continuationColumns) == 0 && len(cfg.skipRow) == 0 {
+		rows, err = readRowsPrefix(f, cfg, cfg.headerRows()+cfg.offset+cfg.limit)
+	} else {
+		rows, err = f.GetRows(cfg.sheet, excelize.Options{RawCellValue: cfg.rawValues})
+	}
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	var grid []nativeRow
+	if cfg.nativeTypes {
+		if grid, err = readNativeCells(f, cfg, rows); err != nil {
+			return nil, nil, nil, err
+		}
+	}
+
+	if cfg.fillMergedCells {
+		if err := fillMergedCells(f, cfg.sheet, rows); err != nil {
+			return nil, nil, nil, err
+		}
+	}
+
+	if cfg.formulas {
+		if err := replaceFormulas(f, cfg.sheet, rows); err != nil {
+			return nil, nil, nil, err
+		}
+	}
+
+	if cfg.transposed {
+		if cfg.groupedHeaders {
+			return nil, nil, nil, fmt.Errorf("grouped headers are not supported with transposed sheets")
+		}
+		rows = transposeRows(rows)
+	}
+
+	if cfg.groupedHeaders {
+		rows = joinGroupRows(rows)
+	}
+
+	var native nativeRows
+	if grid != nil {
+		native = indexNativeRows(rows, grid, cfg)
+	}
+
+	rows, lines := processRows(rows, sheetLines(len(rows), cfg), cfg)
+	return windowRows(rows, cfg.offset, cfg.limit), windowRows(lines, cfg.offset, cfg.limit), native, nil
+}
+
+// processRows applies the configured text normalization and row merging to the rows read from a sheet,
+// keeping the sheet line of each remaining row
+func processRows(rows [][]string, lines []int, cfg *config) ([][]string, []int) {
+	if cfg.normalizeText {
+		normalizeRows(rows)
+	}
+
+	if cfg.trimSpace || cfg.collapseWhitespace || cfg.normalizeUnicode {
+		cleanRows(rows, cfg)
+	}
+
+	if cfg.formulaPrefix != "" {
+		unescapeFormulaRows(rows, cfg.formulaPrefix)
+	}
+
+	if len(cfg.skipRow) > 0 && len(rows) > 0 {
+		rows, lines = skipRows(rows, lines, cfg.skipRow)
+	}
+
+	if len(cfg.continuationColumns) > 0 && len(rows) > 0 {
+		rows, lines = mergeContinuationRows(rows, lines, cfg.continuationColumns, cfg.continuationSeparator)
+	}
+
+	if len(cfg.fillDown) > 0 && len(rows) > 0 {
+		fillDownRows(rows, cfg.fillDown)
+	}
+
+	return rows, lines
+}
+
+// WithFillDown makes blank cells of the given columns inherit the value of the previous data row on import,
+// the layout of spreadsheets where a region or category is only written on the first row of its group
+func WithFillDown(columns ...string) Option {
+	return func(c *config) {
+		c.fillDown = append(c.fillDown, columns...)
+	}
+}
+
+// fillDownRows copies the last non-blank value of each of the columns into the blank cells below it
+func fillDownRows(rows [][]string, columns []string) {
+	for _, column := range columns {
+		col := indexOf(rows[0], column)
+		if col == -1 {
+			continue
+		}
+
+		last := ""
+		for r := 1; r < len(rows); r++ {
+			if strings.TrimSpace(textAt(rows[r], col)) != "" {
+				last = rows[r][col]
+				continue
+			}
+			if last == "" {
+				continue
+			}
+			for len(rows[r]) <= col {
+				rows[r] = append(rows[r], "")
+			}
+			rows[r][col] = last
+		}
+	}
+}
+
+// mergeContinuationRows appends the text of continuation rows to the preceding data row and drops them.
+// Each merged record keeps the sheet line of its first row.
+func mergeContinuationRows(rows [][]string, lines []int, columns []string, separator string) ([][]string, []int) {
+	allowed := make(map[int]bool)
+	for i, header := range rows[0] {
+		for _, column := range columns {
+			if header == column {
+				allowed[i] = true
+			}
+		}
+	}
+
+	merged, mergedLines := [][]string{rows[0]}, []int{lines[0]}
+	for r, row := range rows[1:] {
+		if len(merged) > 1 && isContinuationRow(row, allowed) {
+			previous := merged[len(merged)-1]
+			for i, cell := range row {
+				if cell == "" {
+					continue
+				}
+				for len(previous) <= i {
+					previous = append(previous, "")
+				}
+				if previous[i] == "" {
+					previous[i] = cell
+				} else {
+					previous[i] += separator + cell
+				}
+			}
+			merged[len(merged)-1] = previous
+			continue
+		}
+		merged = append(merged, row)
+		mergedLines = append(mergedLines, lines[r+1])
+	}
+
+	return merged, mergedLines
+}
+
+// isContinuationRow reports whether the row has content and all of it is in the allowed columns
+func isContinuationRow(row []string, allowed map[int]bool) bool {
+	hasContent := false
+	for i, cell := range row {
+		if cell == "" {
+			continue
+		}
+		if !allowed[i] {
+			return false
+		}
+		hasContent = true
+	}
+	return hasContent
+}
+
+// replaceFormulas overwrites the cached value of formula cells with their formula text.
+// Rows are widened to the sheet width since formula cells without a cached value are trimmed by GetRows.
+func replaceFormulas(f *excelize.File, sheet string, rows [][]string) error {
+	width := 0
+	for _, row := range rows {
+		if len(row) > width {
+			width = len(row)
+		}
+	}
+
+	for r := range rows {
+		for c := 0; c < width; c++ {
+			cell, err := excelize.CoordinatesToCellName(c+1, r+1)
+			if err != nil {
+				return err
+			}
+			formula, err := f.GetCellFormula(sheet, cell)
+			if err != nil {
+				return err
+			}
+			if formula == "" {
+				continue
+			}
+			for len(rows[r]) <= c {
+				rows[r] = append(rows[r], "")
+			}
+			rows[r][c] = "=" + formula
+		}
+	}
+
+	return nil
+}
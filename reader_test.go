@@ -0,0 +1,183 @@
+package xlsx_utilities
+
+import (
+	"errors"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/xuri/excelize/v2"
+)
+
+func TestReadFormulas(t *testing.T) {
+	filename := "test_formulas.xlsx"
+	defer os.Remove(filename)
+
+	f := excelize.NewFile()
+	f.SetCellValue("Sheet1", "A1", "Price")
+	f.SetCellValue("Sheet1", "B1", "Total")
+	f.SetCellValue("Sheet1", "A2", 10)
+	f.SetCellFormula("Sheet1", "B2", "A2*2")
+	f.SetCellValue("Sheet1", "B2", 20) // cached value
+	f.SetCellFormula("Sheet1", "B2", "A2*2")
+	f.SetCellValue("Sheet1", "A3", 5)
+	f.SetCellFormula("Sheet1", "B3", "A3*2") // no cached value
+	assert.NoError(t, f.SaveAs(filename))
+	f.Close()
+
+	t.Run("Computed values by default", func(t *testing.T) {
+		excelData, err := FromExcel[person](filename)
+		assert.NoError(t, err)
+		assert.Equal(t, []interface{}{10, 20}, excelData.Rows[0])
+	})
+
+	t.Run("Formula text", func(t *testing.T) {
+		excelData, err := FromExcel[person](filename, WithFormulas())
+		assert.NoError(t, err)
+		assert.Equal(t, []interface{}{10, "=A2*2"}, excelData.Rows[0])
+		assert.Equal(t, []interface{}{5, "=A3*2"}, excelData.Rows[1])
+	})
+
+	t.Run("Reads configured sheet", func(t *testing.T) {
+		_, err := FromExcel[person](filename, WithSheet("Missing"))
+		assert.Error(t, err)
+	})
+}
+
+func TestContinuationRows(t *testing.T) {
+	filename := "test_continuation.xlsx"
+	defer os.Remove(filename)
+
+	type note struct {
+		ID   int
+		Text string
+	}
+
+	f := excelize.NewFile()
+	f.SetSheetRow("Sheet1", "A1", &[]interface{}{"ID", "Text"})
+	f.SetSheetRow("Sheet1", "A2", &[]interface{}{1, "This is a long"})
+	f.SetSheetRow("Sheet1", "A3", &[]interface{}{nil, "note that overflowed"})
+	f.SetSheetRow("Sheet1", "A4", &[]interface{}{nil, "into three rows"})
+	f.SetSheetRow("Sheet1", "A5", &[]interface{}{2, "Short note"})
+	assert.NoError(t, f.SaveAs(filename))
+	f.Close()
+
+	t.Run("Without merge policy", func(t *testing.T) {
+		excelData, err := FromExcel[note](filename)
+		assert.NoError(t, err)
+		assert.Len(t, excelData.Rows, 4)
+	})
+
+	t.Run("Merge continuation rows", func(t *testing.T) {
+		excelData, err := FromExcel[note](filename, WithContinuationRows(" ", "Text"))
+		assert.NoError(t, err)
+
+		result := excelData.ToStruct()
+		assert.Empty(t, result.Errors)
+		assert.Equal(t, []note{
+			{ID: 1, Text: "This is a long note that overflowed into three rows"},
+			{ID: 2, Text: "Short note"},
+		}, result.Data)
+	})
+
+	t.Run("Error rows after a merge", func(t *testing.T) {
+		rejectShort := WithAfterReadRow(func(rowIndex int, item *note) error {
+			if item.ID == 2 {
+				return errors.New("rejected")
+			}
+			return nil
+		})
+		excelData, err := FromExcel[note](filename, WithContinuationRows(" ", "Text"), rejectShort)
+		assert.NoError(t, err)
+
+		result := excelData.ToStruct()
+		if assert.Len(t, result.Errors, 1) {
+			assert.Equal(t, 5, result.Errors[0].RowIndex)
+		}
+	})
+}
+
+func TestSkipRows(t *testing.T) {
+	filename := "test_skip_rows.xlsx"
+	defer os.Remove(filename)
+
+	f := excelize.NewFile()
+	f.SetSheetRow("Sheet1", "A1", &[]interface{}{"Name", "Age"})
+	f.SetSheetRow("Sheet1", "A2", &[]interface{}{"Alice", 30})
+	f.SetSheetRow("Sheet1", "A4", &[]interface{}{"# comment"})
+	f.SetSheetRow("Sheet1", "A5", &[]interface{}{"Bob", 25})
+	f.SetSheetRow("Sheet1", "A7", &[]interface{}{"  ", ""})
+	assert.NoError(t, f.SaveAs(filename))
+	f.Close()
+
+	t.Run("Blank rows", func(t *testing.T) {
+		excelData, err := FromExcel[person](filename, WithSkipBlankRows())
+		assert.NoError(t, err)
+		assert.Equal(t, [][]interface{}{{"Alice", 30}, {"# comment"}, {"Bob", 25}}, excelData.Rows)
+	})
+
+	t.Run("Predicate", func(t *testing.T) {
+		comment := func(cells []string) bool {
+			return len(cells) > 0 && strings.HasPrefix(cells[0], "#")
+		}
+		excelData, err := FromExcel[person](filename, WithSkipBlankRows(), WithSkipRow(comment), WithLimit(2))
+		assert.NoError(t, err)
+		assert.Equal(t, [][]interface{}{{"Alice", 30}, {"Bob", 25}}, excelData.Rows)
+	})
+
+	t.Run("Error rows", func(t *testing.T) {
+		type dated struct {
+			Name string
+			Born time.Time
+		}
+		filename := "test_skip_rows_errors.xlsx"
+		defer os.Remove(filename)
+
+		f := excelize.NewFile()
+		f.SetSheetRow("Sheet1", "A1", &[]interface{}{"Name", "Born"})
+		f.SetSheetRow("Sheet1", "A2", &[]interface{}{"Alice", "1990-01-02"})
+		f.SetSheetRow("Sheet1", "A4", &[]interface{}{"Bob", "unknown"})
+		assert.NoError(t, f.SaveAs(filename))
+		f.Close()
+
+		excelData, err := FromExcel[dated](filename, WithSkipBlankRows())
+		assert.NoError(t, err)
+		result := excelData.ToStruct()
+		if assert.Len(t, result.Errors, 1) {
+			assert.Equal(t, 4, result.Errors[0].RowIndex)
+			assert.Equal(t, "B4", result.Errors[0].Cell)
+		}
+	})
+}
+
+func TestFillDown(t *testing.T) {
+	type sale struct {
+		Region   string
+		Category string
+		Amount   int
+	}
+	filename := "test_fill_down.xlsx"
+	defer os.Remove(filename)
+
+	f := excelize.NewFile()
+	f.SetSheetRow("Sheet1", "A1", &[]interface{}{"Region", "Category", "Amount"})
+	f.SetSheetRow("Sheet1", "A2", &[]interface{}{"North", "Tools", 10})
+	f.SetSheetRow("Sheet1", "A3", &[]interface{}{"", "", 20})
+	f.SetSheetRow("Sheet1", "A4", &[]interface{}{" ", "Garden", 30})
+	f.SetSheetRow("Sheet1", "A5", &[]interface{}{"South"})
+	f.SetSheetRow("Sheet1", "A6", &[]interface{}{"", "", 50})
+	assert.NoError(t, f.SaveAs(filename))
+	f.Close()
+
+	excelData, err := FromExcel[sale](filename, WithFillDown("Region", "Category"))
+	assert.NoError(t, err)
+	assert.Equal(t, [][]interface{}{
+		{"North", "Tools", 10},
+		{"North", "Tools", 20},
+		{"North", "Garden", 30},
+		{"South", "Garden"},
+		{"South", "Garden", 50},
+	}, excelData.Rows)
+}
@@ -0,0 +1,147 @@
+package xlsx_utilities
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// labelSuffix ends the header of the label column written next to an enum-mapped column
+const labelSuffix = " Label"
+
+// WithReferenceSheet writes the codes and labels of enum-mapped columns (map tag option or
+// RegisterEnumLabels) to a hidden reference sheet of the given name. The columns then hold the codes,
+// edited from a drop-down list of the valid codes, and a "<header> Label" column after the data shows
+// the label of each code with a VLOOKUP formula. Imports with the option skip the label columns.
+// Transposed sheets are not supported.
+func WithReferenceSheet(name string) Option {
+	return func(c *config) {
+		c.referenceSheet = name
+	}
+}
+
+// enumColumns returns the indexes of the headers of struct type t with enum labels
+func enumColumns(t reflect.Type, headers []string) []int {
+	var columns []int
+	for i, header := range headers {
+		if planField(t, header).enum != nil {
+			columns = append(columns, i)
+		}
+	}
+	return columns
+}
+
+// labelColumns returns the label columns of a sheet written with a reference sheet, to be skipped on import
+func labelColumns(t reflect.Type, headers []string) map[int]bool {
+	ignored := make(map[int]bool)
+	for i, header := range headers {
+		base, ok := strings.CutSuffix(header, labelSuffix)
+		if ok && planField(t, header).err != nil && planField(t, base).enum != nil {
+			ignored[i] = true
+		}
+	}
+	return ignored
+}
+
+// applyReferenceSheet writes the reference sheet and turns the enum-mapped columns into validated codes with label lookups
+func applyReferenceSheet(f *excelize.File, layout sheetLayout, cfg *config, t reflect.Type, headers []string, rows [][]interface{}) error {
+	if cfg.referenceSheet == "" {
+		return nil
+	}
+	columns := enumColumns(t, headers)
+	if len(columns) == 0 {
+		return nil
+	}
+	if layout.Transposed {
+		return fmt.Errorf("reference sheets are not supported with transposed sheets")
+	}
+
+	ref := cfg.referenceSheet
+	if err := ensureSheet(f, ref); err != nil {
+		return err
+	}
+	if err := f.SetSheetVisible(ref, false); err != nil {
+		return err
+	}
+
+	for n, col := range columns {
+		enum := planField(t, headers[col]).enum
+		codes := make([]string, 0, len(enum.labels))
+		for code := range enum.labels {
+			codes = append(codes, code)
+		}
+		sort.Strings(codes)
+
+		// the codes and labels of the column, below a header row, in a pair of columns of the reference sheet
+		codeCol, labelCol := intToExcelColumn(2*n), intToExcelColumn(2*n+1)
+		if err := f.SetCellValue(ref, codeCol+"1", headers[col]); err != nil {
+			return err
+		}
+		if err := f.SetCellValue(ref, labelCol+"1", headers[col]+labelSuffix); err != nil {
+			return err
+		}
+		for i, code := range codes {
+			if err := f.SetCellValue(ref, fmt.Sprintf("%s%d", codeCol, i+2), codeValue(code)); err != nil {
+				return err
+			}
+			if err := f.SetCellValue(ref, fmt.Sprintf("%s%d", labelCol, i+2), enum.labels[code]); err != nil {
+				return err
+			}
+		}
+		last := len(codes) + 1
+		if last < 2 {
+			last = 2
+		}
+		table := fmt.Sprintf("%s!$%s$2:$%s$%d", quoteSheetName(ref), codeCol, labelCol, last)
+
+		// the data cells hold the codes, from a drop-down list of the codes on the reference sheet
+		for rowIndex, row := range rows {
+			value := cellAt(row, col)
+			if value == nil {
+				continue
+			}
+			if err := f.SetCellValue(layout.Sheet, layout.cell(col, layout.firstDataRow()+rowIndex), codeValue(fmt.Sprint(enum.code(value)))); err != nil {
+				return err
+			}
+		}
+		if layout.Rows > 0 {
+			dv := excelize.NewDataValidation(true)
+			dv.Sqref = layout.columnRef(col)
+			dv.SetSqrefDropList(fmt.Sprintf("%s!$%s$2:$%s$%d", quoteSheetName(ref), codeCol, codeCol, last))
+			if err := f.AddDataValidation(layout.Sheet, dv); err != nil {
+				return err
+			}
+		}
+
+		// the label column after the data looks the code up in the reference table
+		labelOffset := layout.Cols + n
+		if layout.HeaderRow {
+			if err := f.SetCellValue(layout.Sheet, layout.cell(labelOffset, layout.Row), headers[col]+labelSuffix); err != nil {
+				return err
+			}
+		}
+		for rowIndex := 0; rowIndex < layout.Rows; rowIndex++ {
+			row := layout.firstDataRow() + rowIndex
+			formula := fmt.Sprintf(`IFERROR(VLOOKUP(%s,%s,2,FALSE),"")`, layout.cell(col, row), table)
+			if err := f.SetCellFormula(layout.Sheet, layout.cell(labelOffset, row), formula); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// codeValue returns a code as a number when it is one, so it matches the codes typed into cells
+func codeValue(code string) interface{} {
+	if n, err := strconv.ParseInt(code, 10, 64); err == nil {
+		return n
+	}
+	if x, err := strconv.ParseFloat(code, 64); err == nil {
+		return x
+	}
+	return code
+}
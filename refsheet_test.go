@@ -0,0 +1,61 @@
+package xlsx_utilities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReferenceSheet(t *testing.T) {
+	type ticket struct {
+		ID     int
+		Status int `xlsx:"Status,map=1:Active;2:Inactive"`
+		Note   string
+	}
+	data := []ticket{{ID: 1, Status: 2, Note: "a"}, {ID: 2, Status: 1, Note: "b"}}
+
+	excelData, err := FromStruct(data)
+	assert.NoError(t, err)
+
+	f, err := excelData.ToWorkbook(WithReferenceSheet("Lists"))
+	assert.NoError(t, err)
+	defer f.Close()
+
+	t.Run("Hidden reference table", func(t *testing.T) {
+		visible, err := f.GetSheetVisible("Lists")
+		assert.NoError(t, err)
+		assert.False(t, visible)
+
+		rows, err := f.GetRows("Lists")
+		assert.NoError(t, err)
+		assert.Equal(t, [][]string{{"Status", "Status Label"}, {"1", "Active"}, {"2", "Inactive"}}, rows)
+	})
+
+	t.Run("Codes with validation and label lookups", func(t *testing.T) {
+		rows, err := f.GetRows("Sheet1")
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"ID", "Status", "Note", "Status Label"}, rows[0])
+		assert.Equal(t, "2", rows[1][1])
+
+		formula, err := f.GetCellFormula("Sheet1", "D2")
+		assert.NoError(t, err)
+		assert.Equal(t, `IFERROR(VLOOKUP(B2,Lists!$A$2:$B$3,2,FALSE),"")`, formula)
+
+		validations, err := f.GetDataValidations("Sheet1")
+		assert.NoError(t, err)
+		assert.Len(t, validations, 1)
+		assert.Equal(t, "B2:B3", validations[0].Sqref)
+		assert.Equal(t, "Lists!$A$2:$A$3", validations[0].Formula1)
+	})
+
+	t.Run("Read back", func(t *testing.T) {
+		filename := t.TempDir() + "/tickets.xlsx"
+		assert.NoError(t, f.SaveAs(filename))
+
+		read, err := FromExcel[ticket](filename, WithReferenceSheet("Lists"))
+		assert.NoError(t, err)
+		result := read.ToStruct()
+		assert.Empty(t, result.Errors)
+		assert.Equal(t, data, result.Data)
+	})
+}
@@ -0,0 +1,84 @@
+package xlsx_utilities
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// WithStrictRoundTrip makes FromStruct fail when the data contains fields that cannot be
+// exported and imported back without loss, such as non-empty slices, maps or unsupported kinds.
+// The error lists the offending field paths.
+func WithStrictRoundTrip() Option {
+	return func(c *config) {
+		c.strictRoundTrip = true
+	}
+}
+
+// roundTripIssues collects the lossy fields of a value, keyed by field path to report each path once
+type roundTripIssues struct {
+	paths   []string
+	reasons map[string]string
+}
+
+func (r *roundTripIssues) add(path, reason string) {
+	if r.reasons == nil {
+		r.reasons = make(map[string]string)
+	}
+	if _, ok := r.reasons[path]; ok {
+		return
+	}
+	r.paths = append(r.paths, path)
+	r.reasons[path] = reason
+}
+
+func (r *roundTripIssues) err() error {
+	if len(r.paths) == 0 {
+		return nil
+	}
+	details := make([]string, 0, len(r.paths))
+	for _, path := range r.paths {
+		details = append(details, fmt.Sprintf("%s (%s)", path, r.reasons[path]))
+	}
+	return fmt.Errorf("data cannot round-trip losslessly: %s", strings.Join(details, ", "))
+}
+
+// checkRoundTrip walks a value and records the fields that would be exported with data loss
+func checkRoundTrip(v reflect.Value, path string, issues *roundTripIssues) {
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return
+		}
+		v = v.Elem()
+	}
+
+	if _, ok := converterFor(v.Type()); ok {
+		return
+	}
+
+	switch v.Kind() {
+	case reflect.Struct:
+		if v.Type() == reflect.TypeOf(time.Time{}) {
+			return
+		}
+		for i := 0; i < v.NumField(); i++ {
+			field := v.Type().Field(i)
+			if !isColumnField(field) || isJSONField(field) {
+				continue
+			}
+			fieldPath := field.Name
+			if path != "" {
+				fieldPath = path + "." + field.Name
+			}
+			checkRoundTrip(v.Field(i), fieldPath, issues)
+		}
+	case reflect.Slice:
+		if v.Len() > 0 {
+			issues.add(path, "slice elements are not exported")
+		}
+	case reflect.Map, reflect.Array, reflect.Chan, reflect.Func, reflect.Interface,
+		reflect.Complex64, reflect.Complex128, reflect.UnsafePointer:
+		issues.add(path, fmt.Sprintf("unsupported kind %v", v.Kind()))
+	}
+}
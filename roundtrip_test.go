@@ -0,0 +1,53 @@
+package xlsx_utilities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStrictRoundTrip(t *testing.T) {
+	type contact struct {
+		Email string
+	}
+
+	type office struct {
+		City string
+	}
+
+	type account struct {
+		Name     string
+		Contacts []contact
+		Labels   map[string]string
+	}
+
+	type profile struct {
+		Name     string
+		Office   *office
+		Contacts []contact
+	}
+
+	t.Run("Lossless data", func(t *testing.T) {
+		data := []*profile{{Name: "Alice", Office: &office{City: "Jakarta"}}}
+		_, err := FromStruct(data, WithStrictRoundTrip())
+		assert.NoError(t, err)
+	})
+
+	t.Run("Lossy fields are listed", func(t *testing.T) {
+		data := []*account{
+			{Name: "Alice", Contacts: []contact{{Email: "a@example.com"}, {Email: "b@example.com"}}},
+			{Name: "Bob", Labels: map[string]string{"team": "ops"}},
+			{Name: "Carol", Labels: map[string]string{"team": "dev"}},
+		}
+
+		_, err := FromStruct(data, WithStrictRoundTrip())
+		assert.Error(t, err)
+		assert.Equal(t, "data cannot round-trip losslessly: Contacts (slice elements are not exported), Labels (unsupported kind map)", err.Error())
+	})
+
+	t.Run("Lenient by default", func(t *testing.T) {
+		data := []*account{{Name: "Bob", Labels: map[string]string{"team": "ops"}}}
+		_, err := FromStruct(data)
+		assert.NoError(t, err)
+	})
+}
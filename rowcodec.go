@@ -0,0 +1,112 @@
+package xlsx_utilities
+
+import (
+	"reflect"
+	"strconv"
+	"time"
+)
+
+// RowMarshaler is implemented by types that produce their own row of cell values in header order,
+// typically through code generated by cmd/xlsxrowgen. FromStruct and StreamWriter use it instead of reflection.
+type RowMarshaler interface {
+	MarshalXLSXRow() ([]interface{}, error)
+}
+
+// FieldSetter is implemented by pointers to types that set a field from the cell value of its header,
+// typically through code generated by cmd/xlsxrowgen. ToStruct uses it instead of reflection.
+type FieldSetter interface {
+	SetXLSXField(header string, value interface{}) error
+}
+
+// CellString returns the text of a cell value, with nil as an empty string
+func CellString(value interface{}) (string, error) {
+	return cellText(value), nil
+}
+
+// CellInt parses a cell value as an integer; empty cells are zero
+func CellInt(value interface{}) (int64, error) {
+	switch v := value.(type) {
+	case int:
+		return int64(v), nil
+	case int64:
+		return v, nil
+	}
+	text := cellText(value)
+	if text == "" {
+		return 0, nil
+	}
+	n, err := strconv.ParseInt(text, 10, 64)
+	if err != nil {
+		return 0, conversionError(value, n, err)
+	}
+	return n, nil
+}
+
+// CellUint parses a cell value as an unsigned integer; empty cells are zero
+func CellUint(value interface{}) (uint64, error) {
+	text := cellText(value)
+	if text == "" {
+		return 0, nil
+	}
+	n, err := strconv.ParseUint(text, 10, 64)
+	if err != nil {
+		return 0, conversionError(value, n, err)
+	}
+	return n, nil
+}
+
+// CellFloat parses a cell value as a float; empty cells are zero
+func CellFloat(value interface{}) (float64, error) {
+	switch v := value.(type) {
+	case float64:
+		return v, nil
+	case int:
+		return float64(v), nil
+	}
+	text := cellText(value)
+	if text == "" {
+		return 0, nil
+	}
+	f, err := strconv.ParseFloat(text, 64)
+	if err != nil {
+		return 0, conversionError(value, f, err)
+	}
+	return f, nil
+}
+
+// CellBool parses a cell value as a bool; empty cells are false
+func CellBool(value interface{}) (bool, error) {
+	if b, ok := value.(bool); ok {
+		return b, nil
+	}
+	text := cellText(value)
+	if text == "" {
+		return false, nil
+	}
+	b, err := strconv.ParseBool(text)
+	if err != nil {
+		return false, conversionError(value, b, err)
+	}
+	return b, nil
+}
+
+// CellTime parses a cell value as an RFC 3339 time; empty cells are the zero time
+func CellTime(value interface{}) (time.Time, error) {
+	if t, ok := value.(time.Time); ok {
+		return t, nil
+	}
+	text := cellText(value)
+	if text == "" {
+		return time.Time{}, nil
+	}
+	t, err := time.Parse(time.RFC3339, text)
+	if err != nil {
+		return time.Time{}, conversionError(value, t, err)
+	}
+	return t, nil
+}
+
+// conversionError reports a value that cannot be parsed as the type of zero
+func conversionError(value, zero interface{}, err error) error {
+	return &ConversionError{Value: value, Type: reflect.TypeOf(zero), Err: err}
+}
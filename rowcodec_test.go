@@ -0,0 +1,92 @@
+package xlsx_utilities
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// coded implements the row codec by hand, the way cmd/xlsxrowgen generates it
+type coded struct {
+	Name  string
+	Count int
+}
+
+func (r coded) MarshalXLSXRow() ([]interface{}, error) {
+	return []interface{}{"coded " + r.Name, r.Count}, nil
+}
+
+func (r *coded) SetXLSXField(header string, value interface{}) error {
+	switch header {
+	case "Name":
+		v, err := CellString(value)
+		if err != nil {
+			return err
+		}
+		r.Name = v
+	case "Count":
+		v, err := CellInt(value)
+		if err != nil {
+			return err
+		}
+		r.Count = int(v)
+	default:
+		return fmt.Errorf("no such field: %s", header)
+	}
+	return nil
+}
+
+func TestRowCodec(t *testing.T) {
+	t.Run("FromStruct uses MarshalXLSXRow", func(t *testing.T) {
+		excelData, err := FromStruct([]coded{{Name: "a", Count: 1}})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"Name", "Count"}, excelData.Headers)
+		assert.Equal(t, [][]interface{}{{"coded a", 1}}, excelData.Rows)
+	})
+
+	t.Run("ToStruct uses SetXLSXField", func(t *testing.T) {
+		excelData := NewExcelData[coded]([]string{"Name", "Count"})
+		excelData.AddRow([]interface{}{"a", "2"})
+		excelData.AddRow([]interface{}{"b", "two"})
+
+		result := excelData.ToStruct()
+		assert.Equal(t, []coded{{Name: "a", Count: 2}}, result.Data)
+		assert.Len(t, result.Errors, 1)
+		assert.Equal(t, 3, result.Errors[0].RowIndex)
+		assert.Equal(t, "Count", result.Errors[0].Header)
+	})
+
+	t.Run("Cell helpers", func(t *testing.T) {
+		n, err := CellInt("")
+		assert.NoError(t, err)
+		assert.Equal(t, int64(0), n)
+
+		u, err := CellUint(7)
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(7), u)
+
+		f, err := CellFloat("1.5")
+		assert.NoError(t, err)
+		assert.Equal(t, 1.5, f)
+
+		b, err := CellBool("TRUE")
+		assert.NoError(t, err)
+		assert.True(t, b)
+
+		s, err := CellString(nil)
+		assert.NoError(t, err)
+		assert.Equal(t, "", s)
+
+		when, err := CellTime("2024-03-01T09:30:00Z")
+		assert.NoError(t, err)
+		assert.Equal(t, time.Date(2024, 3, 1, 9, 30, 0, 0, time.UTC), when)
+
+		_, err = CellTime("1/3/24")
+		var conversion *ConversionError
+		assert.ErrorAs(t, err, &conversion)
+		assert.Equal(t, reflect.TypeOf(time.Time{}), conversion.Type)
+	})
+}
@@ -0,0 +1,89 @@
+package xlsx_utilities
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"strings"
+	"time"
+)
+
+// sampleSeed seeds the sample values, so generating a template twice gives the same file
+const sampleSeed = 1
+
+// sampleWords are plausible values for text columns, chosen by the first word found in the header
+var sampleWords = []struct {
+	word   string
+	values []string
+}{
+	{"email", []string{"alice@example.com", "bob@example.com", "carol@example.com", "david@example.com"}},
+	{"name", []string{"Alice Johnson", "Bob Smith", "Carol White", "David Brown", "Eve Davis", "Frank Miller"}},
+	{"city", []string{"Amsterdam", "Berlin", "Jakarta", "Lisbon", "Osaka", "Toronto"}},
+	{"country", []string{"Germany", "Indonesia", "Japan", "Portugal", "Canada"}},
+	{"phone", []string{"+1 555 0100", "+1 555 0101", "+1 555 0102", "+1 555 0103"}},
+	{"address", []string{"1 Main Street", "22 Station Road", "5 Harbour View", "310 Park Avenue"}},
+}
+
+// WithSampleRows fills import templates with n rows of plausible fake values instead of a single example row:
+// names, emails or cities for text columns with such headers, numbers within the min and max tag options,
+// dates, and the choices of oneof and enum-mapped columns in turn
+func WithSampleRows(n int) Option {
+	return func(c *config) {
+		c.sampleRows = n
+	}
+}
+
+// sampleRows generates n rows of sample values for the columns
+func sampleRows(headers []string, hints []columnHint, n int) [][]interface{} {
+	rng := rand.New(rand.NewSource(sampleSeed))
+	rows := make([][]interface{}, n)
+	for r := range rows {
+		rows[r] = make([]interface{}, len(headers))
+		for i, hint := range hints {
+			rows[r][i] = hint.sample(headers[i], r, rng)
+		}
+	}
+	return rows
+}
+
+// sample returns a plausible value for row r of the column
+func (h columnHint) sample(header string, r int, rng *rand.Rand) interface{} {
+	switch h.kind {
+	case kindText:
+		lower := strings.ToLower(header)
+		for _, sample := range sampleWords {
+			if strings.Contains(lower, sample.word) {
+				return h.fitLength(sample.values[r%len(sample.values)])
+			}
+		}
+		return h.fitLength(fmt.Sprintf("%s %d", header, r+1))
+	case kindWhole:
+		low, high := h.bounds(1, 100)
+		return int(math.Ceil(low)) + rng.Intn(int(math.Floor(high)-math.Ceil(low))+1)
+	case kindDecimal:
+		low, high := h.bounds(0, 1000)
+		return math.Round((low+rng.Float64()*(high-low))*100) / 100
+	case kindBool:
+		return r%2 == 0
+	case kindDate:
+		return time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC).AddDate(0, 0, rng.Intn(366))
+	case kindChoice:
+		if len(h.choices) > 0 {
+			return h.choices[r%len(h.choices)]
+		}
+	}
+	return h.example()
+}
+
+// bounds returns the range of sample numbers: the min and max tag options, or a default range next to them
+func (h columnHint) bounds(low, high float64) (float64, float64) {
+	switch {
+	case h.min != nil && h.max != nil:
+		low, high = *h.min, max(*h.min, *h.max)
+	case h.min != nil:
+		low, high = *h.min, *h.min+high-low
+	case h.max != nil:
+		low, high = *h.max-(high-low), *h.max
+	}
+	return low, high
+}
@@ -0,0 +1,47 @@
+package xlsx_utilities
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSampleRows(t *testing.T) {
+	type customer struct {
+		Name    string
+		Email   string
+		Score   int     `xlsx:"Score,min=10,max=20"`
+		Balance float64 `xlsx:"Balance,max=0"`
+		Tier    string  `xlsx:"Tier,oneof=Gold;Silver"`
+	}
+	filename := "test_sample_rows.xlsx"
+	defer os.Remove(filename)
+
+	assert.NoError(t, GenerateTemplate[customer](filename, WithSampleRows(5)))
+
+	imported, err := FromExcel[customer](filename)
+	assert.NoError(t, err)
+	result := imported.ToStruct()
+	assert.Empty(t, result.Errors)
+	assert.Len(t, result.Data, 5)
+
+	for i, c := range result.Data {
+		assert.Equal(t, sampleWords[1].values[i], c.Name)
+		assert.Contains(t, c.Email, "@example.com")
+		assert.GreaterOrEqual(t, c.Score, 10)
+		assert.LessOrEqual(t, c.Score, 20)
+		assert.LessOrEqual(t, c.Balance, 0.0)
+		assert.Equal(t, []string{"Gold", "Silver"}[i%2], c.Tier)
+	}
+
+	t.Run("Reproducible", func(t *testing.T) {
+		other := "test_sample_rows_2.xlsx"
+		defer os.Remove(other)
+		assert.NoError(t, GenerateTemplate[customer](other, WithSampleRows(5)))
+
+		again, err := FromExcel[customer](other)
+		assert.NoError(t, err)
+		assert.Equal(t, imported.Rows, again.Rows)
+	})
+}
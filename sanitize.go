@@ -0,0 +1,118 @@
+package xlsx_utilities
+
+import (
+	"strings"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// Sanitizer fixes up a workbook right after it is opened, before the configured sheet is parsed
+type Sanitizer func(f *excelize.File, sheet string) error
+
+// Sanitizers are applied, in order, to every workbook read by FromExcel and FromFileExcel
+var Sanitizers []Sanitizer
+
+// RegisterSanitizer registers a sanitizer applied to every workbook that is read
+func RegisterSanitizer(sanitizer Sanitizer) {
+	Sanitizers = append(Sanitizers, sanitizer)
+}
+
+// WithSanitizers applies the given sanitizers after the registered ones, for this read only
+func WithSanitizers(sanitizers ...Sanitizer) Option {
+	return func(c *config) {
+		c.sanitizers = append(c.sanitizers, sanitizers...)
+	}
+}
+
+// sanitize applies the registered and configured sanitizers to the workbook
+func sanitize(f *excelize.File, cfg *config) error {
+	for _, sanitizers := range [][]Sanitizer{Sanitizers, cfg.sanitizers} {
+		for _, sanitizer := range sanitizers {
+			if err := sanitizer(f, cfg.sheet); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// UnhideSheets is a sanitizer that makes every sheet of the workbook visible
+func UnhideSheets(f *excelize.File, sheet string) error {
+	for _, name := range f.GetSheetList() {
+		if err := f.SetSheetVisible(name, true); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// UnmergeCells is a sanitizer that splits the merged regions of the sheet,
+// copying the value of each region into all of its cells
+func UnmergeCells(f *excelize.File, sheet string) error {
+	merged, err := f.GetMergeCells(sheet)
+	if err != nil {
+		return err
+	}
+
+	for _, region := range merged {
+		startCol, startRow, err := excelize.CellNameToCoordinates(region.GetStartAxis())
+		if err != nil {
+			return err
+		}
+		endCol, endRow, err := excelize.CellNameToCoordinates(region.GetEndAxis())
+		if err != nil {
+			return err
+		}
+
+		value := region.GetCellValue()
+		if err := f.UnmergeCell(sheet, region.GetStartAxis(), region.GetEndAxis()); err != nil {
+			return err
+		}
+
+		for row := startRow; row <= endRow; row++ {
+			for col := startCol; col <= endCol; col++ {
+				cell, err := excelize.CoordinatesToCellName(col, row)
+				if err != nil {
+					return err
+				}
+				if err := f.SetCellValue(sheet, cell, value); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// RemovePhantomColumns is a sanitizer that removes the columns of the sheet whose header cell is blank,
+// as produced by tools that leave stray formatting or whitespace next to the data
+func RemovePhantomColumns(f *excelize.File, sheet string) error {
+	rows, err := f.GetRows(sheet)
+	if err != nil {
+		return err
+	}
+
+	if len(rows) == 0 {
+		return nil
+	}
+
+	width := 0
+	for _, row := range rows {
+		if len(row) > width {
+			width = len(row)
+		}
+	}
+
+	header := rows[0]
+	for col := width - 1; col >= 0; col-- {
+		if col < len(header) && strings.TrimSpace(header[col]) != "" {
+			continue
+		}
+		if err := f.RemoveCol(sheet, intToExcelColumn(col)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,56 @@
+package xlsx_utilities
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/xuri/excelize/v2"
+)
+
+func TestSanitizers(t *testing.T) {
+	type entry struct {
+		Group string
+		Name  string
+	}
+
+	filename := "test_sanitize.xlsx"
+	defer os.Remove(filename)
+
+	// Vendor file with a merged group column and a blank phantom column
+	f := excelize.NewFile()
+	f.SetCellValue("Sheet1", "A1", "Group")
+	f.SetCellValue("Sheet1", "B1", " ")
+	f.SetCellValue("Sheet1", "C1", "Name")
+	f.SetCellValue("Sheet1", "A2", "Ops")
+	f.SetCellValue("Sheet1", "B2", " ")
+	f.SetCellValue("Sheet1", "C2", "Alice")
+	f.SetCellValue("Sheet1", "C3", "Bob")
+	f.MergeCell("Sheet1", "A2", "A3")
+	assert.NoError(t, f.SaveAs(filename))
+	f.Close()
+
+	t.Run("Per read sanitizers", func(t *testing.T) {
+		excelData, err := FromExcel[entry](filename, WithSanitizers(RemovePhantomColumns, UnmergeCells))
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"Group", "Name"}, excelData.Headers)
+
+		result := excelData.ToStruct()
+		assert.Empty(t, result.Errors)
+		assert.Equal(t, []entry{{Group: "Ops", Name: "Alice"}, {Group: "Ops", Name: "Bob"}}, result.Data)
+	})
+
+	t.Run("Registered sanitizers", func(t *testing.T) {
+		defer func(registered []Sanitizer) { Sanitizers = registered }(Sanitizers)
+
+		var sheets []string
+		RegisterSanitizer(func(f *excelize.File, sheet string) error {
+			sheets = append(sheets, sheet)
+			return nil
+		})
+
+		_, err := FromExcel[entry](filename)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"Sheet1"}, sheets)
+	})
+}
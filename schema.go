@@ -0,0 +1,202 @@
+package xlsx_utilities
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"time"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// SchemaSheet is the name of the hidden sheet describing the exported columns
+const SchemaSheet = "_schema"
+
+// schemaHeaders are the columns of the schema sheet
+var schemaHeaders = []interface{}{"Sheet", "Header", "Type", "Format"}
+
+// schemaTypes are the Go types restored exactly from a schema
+var schemaTypes = map[string]reflect.Type{}
+
+func init() {
+	for _, v := range []interface{}{
+		"", false, 0, int8(0), int16(0), int32(0), int64(0),
+		uint(0), uint8(0), uint16(0), uint32(0), uint64(0), float32(0), float64(0), time.Time{},
+	} {
+		schemaTypes[reflect.TypeOf(v).String()] = reflect.TypeOf(v)
+	}
+}
+
+// WithSchema writes a hidden "_schema" sheet describing the columns, Go types and number formats of
+// the export. FromExcel uses the schema whenever it is present, reading each column back as its
+// original Go type instead of inferring types from the cell text.
+func WithSchema() Option {
+	return func(c *config) {
+		c.schema = true
+	}
+}
+
+// schemaColumn describes one exported column
+type schemaColumn struct {
+	Header string
+	Type   string
+	Format string
+}
+
+// applySchema records the columns of the exported sheet in the schema sheet, replacing the
+// columns recorded by an earlier export of the same sheet
+func applySchema(f *excelize.File, layout sheetLayout, cfg *config, headers []string, rows [][]interface{}) error {
+	if !cfg.schema {
+		return nil
+	}
+
+	if err := ensureSheet(f, SchemaSheet); err != nil {
+		return err
+	}
+	existing, err := f.GetRows(SchemaSheet)
+	if err != nil {
+		return err
+	}
+
+	schema := [][]interface{}{schemaHeaders}
+	for _, row := range existing[min(1, len(existing)):] {
+		if len(row) > 0 && row[0] == layout.Sheet {
+			continue
+		}
+		values := make([]interface{}, len(row))
+		for i, text := range row {
+			values[i] = text
+		}
+		schema = append(schema, values)
+	}
+
+	for i, header := range headers {
+		typ := "string"
+		for _, row := range rows {
+			if i < len(row) && row[i] != nil {
+				typ = reflect.TypeOf(row[i]).String()
+				break
+			}
+		}
+
+		format, err := columnFormat(f, layout, i)
+		if err != nil {
+			return err
+		}
+		schema = append(schema, []interface{}{layout.Sheet, header, typ, format})
+	}
+
+	for i := range schema {
+		if err := f.SetSheetRow(SchemaSheet, fmt.Sprintf("A%d", i+1), &schema[i]); err != nil {
+			return err
+		}
+	}
+	for line := len(existing); line > len(schema); line-- {
+		if err := f.RemoveRow(SchemaSheet, line); err != nil {
+			return err
+		}
+	}
+
+	return f.SetSheetVisible(SchemaSheet, false)
+}
+
+// columnFormat returns the number format of the first data cell of the column
+func columnFormat(f *excelize.File, layout sheetLayout, col int) (string, error) {
+	if layout.Rows == 0 {
+		return "", nil
+	}
+
+	id, err := f.GetCellStyle(layout.Sheet, layout.cell(col, layout.firstDataRow()))
+	if err != nil || id == 0 {
+		return "", err
+	}
+	style, err := f.GetStyle(id)
+	if err != nil {
+		return "", err
+	}
+
+	switch {
+	case style.CustomNumFmt != nil:
+		return *style.CustomNumFmt, nil
+	case style.NumFmt != 0:
+		return strconv.Itoa(style.NumFmt), nil
+	}
+	return "", nil
+}
+
+// readSchema returns the columns described for the sheet, or nil when the workbook has no schema
+func readSchema(f *excelize.File, sheet string) ([]schemaColumn, error) {
+	if index, err := f.GetSheetIndex(SchemaSheet); err != nil || index == -1 {
+		return nil, err
+	}
+
+	rows, err := f.GetRows(SchemaSheet)
+	if err != nil {
+		return nil, err
+	}
+
+	var columns []schemaColumn
+	for _, row := range rows[min(1, len(rows)):] {
+		if len(row) < 3 || row[0] != sheet {
+			continue
+		}
+		column := schemaColumn{Header: row[1], Type: row[2]}
+		if len(row) > 3 {
+			column.Format = row[3]
+		}
+		columns = append(columns, column)
+	}
+
+	return columns, nil
+}
+
+// parseSchemaValue converts raw cell text to the Go type recorded in the schema.
+// Text that does not parse, or has an unknown type, is kept as is.
+func parseSchemaValue(typeName, text string) interface{} {
+	t, ok := schemaTypes[typeName]
+	if !ok || t.Kind() == reflect.String {
+		return text
+	}
+	if text == "" {
+		return nil
+	}
+
+	v := reflect.New(t).Elem()
+	switch t.Kind() {
+	case reflect.Bool:
+		b, err := strconv.ParseBool(text)
+		if err != nil {
+			return text
+		}
+		v.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, err := strconv.ParseInt(text, 10, 64)
+		if err != nil {
+			return text
+		}
+		v.SetInt(i)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		u, err := strconv.ParseUint(text, 10, 64)
+		if err != nil {
+			return text
+		}
+		v.SetUint(u)
+	case reflect.Float32, reflect.Float64:
+		fl, err := strconv.ParseFloat(text, 64)
+		if err != nil {
+			return text
+		}
+		v.SetFloat(fl)
+	case reflect.Struct:
+		serial, err := strconv.ParseFloat(text, 64)
+		if err != nil {
+			return text
+		}
+		date, err := excelize.ExcelDateToTime(serial, false)
+		if err != nil {
+			return text
+		}
+		return date
+	}
+	return v.Interface()
+}
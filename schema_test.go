@@ -0,0 +1,56 @@
+package xlsx_utilities
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSchema(t *testing.T) {
+	filename := "test_schema.xlsx"
+	defer os.Remove(filename)
+
+	joined := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	excelData := NewExcelData[person]([]string{"Code", "Count", "Ratio", "Active", "Joined"})
+	excelData.AddRow([]interface{}{"00123", int64(7), 0.25, true, joined})
+	excelData.AddRow([]interface{}{"true", int64(8), 1.5, false, joined.AddDate(0, 1, 0)})
+
+	assert.NoError(t, excelData.Save(filename, WithSchema(), WithLocale(Locale{NumberFormat: "#,##0.00"})))
+
+	t.Run("Hidden schema sheet", func(t *testing.T) {
+		schema, err := FromExcel[person](filename, WithSheet(SchemaSheet))
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"Sheet", "Header", "Type", "Format"}, schema.Headers)
+		assert.Equal(t, []interface{}{"Sheet1", "Count", "int64", "#,##0.00"}, schema.Rows[1])
+		assert.Equal(t, []interface{}{"Sheet1", "Joined", "time.Time", 22}, schema.Rows[4])
+	})
+
+	t.Run("Typed re-import", func(t *testing.T) {
+		imported, err := FromExcel[person](filename)
+		assert.NoError(t, err)
+		assert.Equal(t, excelData.Headers, imported.Headers)
+		assert.Equal(t, excelData.Rows, imported.Rows)
+	})
+
+	t.Run("Repeated export", func(t *testing.T) {
+		other := NewExcelData[person]([]string{"Label"})
+		other.AddRow([]interface{}{"x"})
+
+		f, err := excelData.ToWorkbook(WithSchema())
+		assert.NoError(t, err)
+		defer f.Close()
+		assert.NoError(t, other.AddToWorkbook(f, WithSheet("Other"), WithSchema()))
+		assert.NoError(t, excelData.AddToWorkbook(f, WithSchema()))
+		assert.NoError(t, excelData.AddToWorkbook(f, WithSchema()))
+
+		schema, err := f.GetRows(SchemaSheet)
+		assert.NoError(t, err)
+		assert.Len(t, schema, 1+1+len(excelData.Headers))
+
+		imported, err := fromWorkbook[person](f, []Option{WithSheet("Sheet1")})
+		assert.NoError(t, err)
+		assert.Equal(t, excelData.Rows, imported.Rows)
+	})
+}
@@ -0,0 +1,77 @@
+package xlsx_utilities
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// FromStructSeq converts the items of a sequence to ExcelData as they are produced, e.g. by a database
+// cursor, without collecting them in a slice first. An iter.Seq[T] can be passed as is.
+// To keep the rows out of memory as well, write the sequence with StreamWriter.WriteSeq instead.
+func FromStructSeq[T comparable](seq func(yield func(T) bool), opts ...Option) (*ExcelData[T], error) {
+	cfg := newConfig(opts)
+
+	headers, err := getStructHeaders(reflect.TypeOf((*T)(nil)).Elem())
+	if err != nil {
+		return nil, fmt.Errorf("error getting headers: %w", err)
+	}
+
+	ed := NewExcelData[T](headers)
+	ed.options = opts
+
+	var issues roundTripIssues
+	i := 0
+	seq(func(item T) bool {
+		if cfg.strictRoundTrip {
+			checkRoundTrip(reflect.ValueOf(item), "", &issues)
+		}
+		if err = ed.addStruct(i, item); err != nil {
+			return false
+		}
+		i++
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := issues.err(); err != nil {
+		return nil, err
+	}
+
+	if i == 0 {
+		return nil, fmt.Errorf("input sequence is empty")
+	}
+	return ed, nil
+}
+
+// FromStructChan converts the items received from a channel to ExcelData until it is closed.
+// On error, the rest of the channel is not received.
+func FromStructChan[T comparable](ch <-chan T, opts ...Option) (*ExcelData[T], error) {
+	return FromStructSeq(chanSeq(ch), opts...)
+}
+
+// WriteSeq writes the items of a sequence as they are produced, flushing full batches to the sheet
+func (w *StreamWriter[T]) WriteSeq(seq func(yield func(T) bool)) error {
+	var err error
+	seq(func(item T) bool {
+		err = w.WriteStruct(item)
+		return err == nil
+	})
+	return err
+}
+
+// WriteChan writes the items received from a channel until it is closed
+func (w *StreamWriter[T]) WriteChan(ch <-chan T) error {
+	return w.WriteSeq(chanSeq(ch))
+}
+
+// chanSeq returns a sequence of the values received from a channel
+func chanSeq[T any](ch <-chan T) func(yield func(T) bool) {
+	return func(yield func(T) bool) {
+		for item := range ch {
+			if !yield(item) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,64 @@
+package xlsx_utilities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFromStructSeq(t *testing.T) {
+	people := []person{{Name: "Alice", Age: 30}, {Name: "Bob", Age: 25}}
+	seq := func(yield func(person) bool) {
+		for _, p := range people {
+			if !yield(p) {
+				return
+			}
+		}
+	}
+
+	t.Run("Sequence", func(t *testing.T) {
+		excelData, err := FromStructSeq(seq)
+		assert.NoError(t, err)
+
+		expected, err := FromStruct(people)
+		assert.NoError(t, err)
+		assert.Equal(t, expected.Headers, excelData.Headers)
+		assert.Equal(t, expected.Rows, excelData.Rows)
+	})
+
+	t.Run("Channel", func(t *testing.T) {
+		ch := make(chan person)
+		go func() {
+			defer close(ch)
+			for _, p := range people {
+				ch <- p
+			}
+		}()
+
+		excelData, err := FromStructChan(ch)
+		assert.NoError(t, err)
+		assert.Len(t, excelData.Rows, 2)
+
+		empty := make(chan person)
+		close(empty)
+		_, err = FromStructChan(empty)
+		assert.EqualError(t, err, "input sequence is empty")
+	})
+
+	t.Run("Empty", func(t *testing.T) {
+		_, err := FromStructSeq(func(yield func(person) bool) {})
+		assert.EqualError(t, err, "input sequence is empty")
+	})
+
+	t.Run("Stream writer", func(t *testing.T) {
+		w, err := NewStreamWriter[person](nil, WithBatchSize(1))
+		assert.NoError(t, err)
+		assert.NoError(t, w.WriteSeq(seq))
+		assert.NoError(t, w.Close())
+
+		rows, err := w.File().GetRows("Sheet1")
+		assert.NoError(t, err)
+		assert.Len(t, rows, 3)
+		assert.Equal(t, "Bob", rows[2][0])
+	})
+}
@@ -0,0 +1,66 @@
+package xlsx_utilities
+
+import (
+	"fmt"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// SheetVisibility controls whether a generated sheet is shown in Excel
+type SheetVisibility int
+
+const (
+	// SheetVisible shows the sheet as a normal tab
+	SheetVisible SheetVisibility = iota
+	// SheetHidden hides the sheet; users can unhide it from Excel
+	SheetHidden
+	// SheetVeryHidden hides the sheet so it can only be unhidden through VBA
+	SheetVeryHidden
+)
+
+// WithSheetVisibility sets the visibility of the generated sheet
+func WithSheetVisibility(visibility SheetVisibility) Option {
+	return func(c *config) {
+		c.visibility = visibility
+	}
+}
+
+// WithSheetPassword protects the generated sheet with the given password
+func WithSheetPassword(password string) Option {
+	return func(c *config) {
+		c.password = password
+	}
+}
+
+// applySheetProtection protects the sheet when a password is configured
+func applySheetProtection(f *excelize.File, layout sheetLayout, cfg *config) error {
+	if cfg.password == "" {
+		return nil
+	}
+
+	return f.ProtectSheet(layout.Sheet, &excelize.SheetProtectionOptions{
+		Password:            cfg.password,
+		SelectLockedCells:   true,
+		SelectUnlockedCells: true,
+	})
+}
+
+// applySheetVisibility hides the sheet when configured
+func applySheetVisibility(f *excelize.File, layout sheetLayout, cfg *config) error {
+	if cfg.visibility == SheetVisible {
+		return nil
+	}
+
+	if err := f.SetSheetVisible(layout.Sheet, false, cfg.visibility == SheetVeryHidden); err != nil {
+		return err
+	}
+
+	// excelize silently keeps the sheet visible when it is the active or the only visible sheet
+	if visible, err := f.GetSheetVisible(layout.Sheet); err != nil {
+		return err
+	} else if visible {
+		return fmt.Errorf("sheet '%s' cannot be hidden: a workbook needs another visible, active sheet", layout.Sheet)
+	}
+
+	return nil
+}
@@ -0,0 +1,45 @@
+package xlsx_utilities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSheetVisibilityAndProtection(t *testing.T) {
+	data := []person{{Name: "Alice", Age: 30}}
+	excelData, err := FromStruct(data)
+	assert.NoError(t, err)
+
+	t.Run("Hidden data sheet next to visible sheet", func(t *testing.T) {
+		f, err := excelData.ToWorkbook(WithSheet("Summary"))
+		assert.NoError(t, err)
+		defer f.Close()
+
+		err = excelData.AddToWorkbook(f, WithSheet("Data"), WithSheetVisibility(SheetVeryHidden))
+		assert.NoError(t, err)
+
+		visible, err := f.GetSheetVisible("Data")
+		assert.NoError(t, err)
+		assert.False(t, visible)
+
+		value, _ := f.GetCellValue("Data", "A2")
+		assert.Equal(t, "Alice", value)
+	})
+
+	t.Run("Only sheet cannot be hidden", func(t *testing.T) {
+		_, err := excelData.ToWorkbook(WithSheetVisibility(SheetHidden))
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "cannot be hidden")
+	})
+
+	t.Run("Protected sheet", func(t *testing.T) {
+		f, err := excelData.ToWorkbook(WithSheetPassword("secret"))
+		assert.NoError(t, err)
+		defer f.Close()
+
+		err = f.UnprotectSheet("Sheet1", "wrong")
+		assert.Error(t, err)
+		assert.NoError(t, f.UnprotectSheet("Sheet1", "secret"))
+	})
+}
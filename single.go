@@ -0,0 +1,49 @@
+package xlsx_utilities
+
+import "fmt"
+
+// Headers of a key/value export
+const (
+	FieldHeader = "Field"
+	ValueHeader = "Value"
+)
+
+// FromSingleStruct converts one struct into a two-column Field/Value dataset, one row per flattened field,
+// for "details" exports of a single entity
+func FromSingleStruct[T comparable](item T, opts ...Option) (*ExcelData[T], error) {
+	record, err := FromStruct([]T{item}, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	ed := NewExcelData[T]([]string{FieldHeader, ValueHeader})
+	ed.options = opts
+	for i, header := range record.Headers {
+		ed.Rows = append(ed.Rows, []interface{}{header, record.Rows[0][i]})
+	}
+	return ed, nil
+}
+
+// ToSingleStruct converts a Field/Value dataset, as written by FromSingleStruct, back into one struct
+func (ed *ExcelData[T]) ToSingleStruct() (T, error) {
+	var zero T
+	if len(ed.Headers) != 2 || ed.Headers[0] != FieldHeader || ed.Headers[1] != ValueHeader {
+		return zero, fmt.Errorf("%w: expected %s, %s", ErrHeaderMismatch, FieldHeader, ValueHeader)
+	}
+
+	record := ed.view([][]interface{}{make([]interface{}, len(ed.Rows))})
+	record.Headers = make([]string, len(ed.Rows))
+	for r, row := range ed.Rows {
+		record.Headers[r] = cellText(cellAt(row, 0))
+		record.Rows[0][r] = cellAt(row, 1)
+	}
+
+	result := record.ToStruct()
+	if len(result.Errors) > 0 {
+		return zero, result.Errors[0]
+	}
+	if len(result.Data) == 0 {
+		return zero, fmt.Errorf("no fields to convert")
+	}
+	return result.Data[0], nil
+}
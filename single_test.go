@@ -0,0 +1,50 @@
+package xlsx_utilities
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSingleStruct(t *testing.T) {
+	filename := "test_single.xlsx"
+	defer os.Remove(filename)
+
+	type address struct {
+		Street string
+		City   string
+	}
+	type customer struct {
+		Name    string
+		Age     int
+		Address address
+	}
+	item := customer{Name: "Alice", Age: 30, Address: address{Street: "Main St 1", City: "Springfield"}}
+
+	excelData, err := FromSingleStruct(item)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Field", "Value"}, excelData.Headers)
+	assert.Equal(t, [][]interface{}{
+		{"Name", "Alice"},
+		{"Age", 30},
+		{"Address Street", "Main St 1"},
+		{"Address City", "Springfield"},
+	}, excelData.Rows)
+
+	t.Run("Round trip", func(t *testing.T) {
+		assert.NoError(t, excelData.Save(filename))
+
+		imported, err := FromExcel[customer](filename)
+		assert.NoError(t, err)
+		restored, err := imported.ToSingleStruct()
+		assert.NoError(t, err)
+		assert.Equal(t, item, restored)
+	})
+
+	t.Run("Not a key/value dataset", func(t *testing.T) {
+		_, err := NewExcelData[customer]([]string{"Name", "Age"}).ToSingleStruct()
+		assert.EqualError(t, err, "headers do not match: expected Field, Value")
+		assert.ErrorIs(t, err, ErrHeaderMismatch)
+	})
+}
@@ -0,0 +1,90 @@
+package xlsx_utilities
+
+// view returns a new ExcelData sharing the headers, options and sheet name, over the given rows
+func (ed *ExcelData[T]) view(rows [][]interface{}) *ExcelData[T] {
+	return &ExcelData[T]{
+		Headers: ed.Headers,
+		Rows:    rows,
+		options: ed.options,
+		sheet:   ed.sheet,
+	}
+}
+
+// keepLine appends the sheet line of row r to the view, when the line of every row is known
+func (ed *ExcelData[T]) keepLine(view *ExcelData[T], r int) {
+	if len(ed.lines) == len(ed.Rows) {
+		view.lines = append(view.lines, ed.lines[r])
+	}
+}
+
+// Slice returns a view of at most limit rows starting at offset. A negative limit returns all remaining rows.
+// The view shares row storage with ed; use Clone to get an independent copy.
+func (ed *ExcelData[T]) Slice(offset, limit int) *ExcelData[T] {
+	if offset < 0 {
+		offset = 0
+	}
+	if offset > len(ed.Rows) {
+		offset = len(ed.Rows)
+	}
+
+	end := len(ed.Rows)
+	if limit >= 0 && offset+limit < end {
+		end = offset + limit
+	}
+
+	view := ed.view(ed.Rows[offset:end:end])
+	view.Provenance = ed.sliceProvenance(offset, end)
+	if len(ed.lines) == len(ed.Rows) {
+		view.lines = ed.lines[offset:end:end]
+	}
+	return view
+}
+
+// Head returns a view of the first n rows
+func (ed *ExcelData[T]) Head(n int) *ExcelData[T] {
+	if n < 0 {
+		n = 0
+	}
+	return ed.Slice(0, n)
+}
+
+// Tail returns a view of the last n rows
+func (ed *ExcelData[T]) Tail(n int) *ExcelData[T] {
+	if n < 0 {
+		n = 0
+	}
+	return ed.Slice(len(ed.Rows)-n, n)
+}
+
+// Chunks splits the rows into consecutive views of at most size rows
+func (ed *ExcelData[T]) Chunks(size int) []*ExcelData[T] {
+	if size <= 0 {
+		return nil
+	}
+
+	chunks := make([]*ExcelData[T], 0, (len(ed.Rows)+size-1)/size)
+	for offset := 0; offset < len(ed.Rows); offset += size {
+		chunks = append(chunks, ed.Slice(offset, size))
+	}
+	return chunks
+}
+
+// Clone returns a deep copy of the headers, rows and provenance
+func (ed *ExcelData[T]) Clone() *ExcelData[T] {
+	headers := make([]string, len(ed.Headers))
+	copy(headers, ed.Headers)
+
+	rows := make([][]interface{}, len(ed.Rows))
+	for i, row := range ed.Rows {
+		rows[i] = make([]interface{}, len(row))
+		copy(rows[i], row)
+	}
+
+	clone := ed.view(rows)
+	clone.Headers = headers
+	clone.lines = append([]int(nil), ed.lines...)
+	for _, row := range ed.Provenance {
+		clone.Provenance = append(clone.Provenance, append([]Provenance(nil), row...))
+	}
+	return clone
+}
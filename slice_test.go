@@ -0,0 +1,73 @@
+package xlsx_utilities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSlicing(t *testing.T) {
+	excelData := NewExcelData[person]([]string{"Name", "Age"})
+	for i := 0; i < 5; i++ {
+		excelData.AddRow([]interface{}{"P", i})
+	}
+
+	ages := func(ed *ExcelData[person]) []interface{} {
+		var out []interface{}
+		for _, row := range ed.Rows {
+			out = append(out, row[1])
+		}
+		return out
+	}
+
+	t.Run("Slice", func(t *testing.T) {
+		assert.Equal(t, []interface{}{1, 2}, ages(excelData.Slice(1, 2)))
+		assert.Equal(t, []interface{}{3, 4}, ages(excelData.Slice(3, -1)))
+		assert.Empty(t, excelData.Slice(10, 2).Rows)
+		assert.Equal(t, excelData.Headers, excelData.Slice(0, 1).Headers)
+	})
+
+	t.Run("Head and Tail", func(t *testing.T) {
+		assert.Equal(t, []interface{}{0, 1}, ages(excelData.Head(2)))
+		assert.Equal(t, []interface{}{3, 4}, ages(excelData.Tail(2)))
+		assert.Len(t, excelData.Tail(10).Rows, 5)
+	})
+
+	t.Run("Chunks", func(t *testing.T) {
+		chunks := excelData.Chunks(2)
+		assert.Len(t, chunks, 3)
+		assert.Equal(t, []interface{}{4}, ages(chunks[2]))
+		assert.Nil(t, excelData.Chunks(0))
+	})
+
+	t.Run("Views share rows, clones do not", func(t *testing.T) {
+		view := excelData.Head(1)
+		clone := excelData.Clone()
+		view.Rows[0][0] = "Changed"
+		assert.Equal(t, "Changed", excelData.Rows[0][0])
+		assert.Equal(t, "P", clone.Rows[0][0])
+
+		// Appending to a view must not overwrite the parent's rows
+		view.AddRow([]interface{}{"New", 99})
+		assert.Equal(t, 1, excelData.Rows[1][1])
+	})
+
+	t.Run("Views keep the sheet name", func(t *testing.T) {
+		read := excelData.Clone()
+		read.sheet = "People"
+		dedupe, _, err := read.Dedupe("Age")
+		assert.NoError(t, err)
+		piped, err := read.Pipe(Filter(func(Row) bool { return true }), Limit(2))
+		assert.NoError(t, err)
+
+		for _, view := range []*ExcelData[person]{
+			read.Slice(1, 2),
+			read.Filter(func(Row) bool { return true }),
+			read.Sort(func(a, b Row) bool { return false }),
+			dedupe,
+			piped,
+		} {
+			assert.Equal(t, "People", view.ToStruct().Sheet)
+		}
+	})
+}
@@ -0,0 +1,141 @@
+package xlsx_utilities
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// blankGroup names the group of rows with an empty value in the split column
+const blankGroup = "(blank)"
+
+// Group holds the rows sharing one value of the column a dataset was split by
+type Group[T comparable] struct {
+	Value string
+	*ExcelData[T]
+}
+
+// SplitBy groups the rows by the value of the column, in order of first appearance.
+// Each group is a view sharing row storage with ed; rows with an empty value form the "(blank)" group.
+func (ed *ExcelData[T]) SplitBy(header string) ([]Group[T], error) {
+	col := -1
+	for i, h := range ed.Headers {
+		if h == header {
+			col = i
+			break
+		}
+	}
+	if col == -1 {
+		return nil, fmt.Errorf("unknown split column '%s'", header)
+	}
+
+	var groups []Group[T]
+	index := make(map[string]int)
+	for r, row := range ed.Rows {
+		value := blankGroup
+		if col < len(row) && row[col] != nil && fmt.Sprint(row[col]) != "" {
+			value = fmt.Sprint(row[col])
+		}
+
+		i, ok := index[value]
+		if !ok {
+			i = len(groups)
+			index[value] = i
+			groups = append(groups, Group[T]{Value: value, ExcelData: ed.view(nil)})
+		}
+
+		group := groups[i].ExcelData
+		group.Rows = append(group.Rows, row)
+		ed.keepLine(group, r)
+		if len(ed.Provenance) > 0 {
+			group.Provenance = append(group.Provenance, ed.rowProvenance(r))
+		}
+	}
+
+	return groups, nil
+}
+
+// SplitToSheets writes one sheet per value of the column, named after the value, all using the same options.
+// Tables get the group number appended to their name since table names are unique within a workbook.
+func (ed *ExcelData[T]) SplitToSheets(header string, opts ...Option) (*excelize.File, error) {
+	groups, err := ed.SplitBy(header)
+	if err != nil {
+		return nil, err
+	}
+	if len(groups) == 0 {
+		return nil, fmt.Errorf("no rows to split by '%s'", header)
+	}
+
+	names := make(map[string]bool)
+	var f *excelize.File
+	for i, group := range groups {
+		sheet := groupSheet(group.Value, names)
+		groupOpts := append(append([]Option(nil), opts...), WithSheet(sheet), groupTable(i))
+
+		if f == nil {
+			if f, err = group.ToWorkbook(groupOpts...); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if err := group.AddToWorkbook(f, groupOpts...); err != nil {
+			f.Close()
+			return nil, err
+		}
+	}
+
+	return f, nil
+}
+
+// SplitToFiles saves one workbook per value of the column, all using the same options.
+// The filename function returns the path of the workbook for a value.
+func (ed *ExcelData[T]) SplitToFiles(header string, filename func(value string) string, opts ...Option) error {
+	groups, err := ed.SplitBy(header)
+	if err != nil {
+		return err
+	}
+
+	for _, group := range groups {
+		if err := group.Save(filename(group.Value), opts...); err != nil {
+			return fmt.Errorf("error saving group '%s': %w", group.Value, err)
+		}
+	}
+	return nil
+}
+
+// groupTable suffixes the table name of the i-th group (0-based) after the first
+func groupTable(i int) Option {
+	return func(c *config) {
+		if c.table == nil || i == 0 {
+			return
+		}
+		table := *c.table
+		table.name = fmt.Sprintf("%s_%d", table.name, i+1)
+		c.table = &table
+	}
+}
+
+// groupSheet returns a valid sheet name for the value, unique among the names already used
+func groupSheet(value string, used map[string]bool) string {
+	name := strings.Map(func(r rune) rune {
+		if strings.ContainsRune(`:\/?*[]`, r) {
+			return '_'
+		}
+		return r
+	}, value)
+	name = strings.Trim(name, "'")
+	if name == "" {
+		name = blankGroup
+	}
+	if runes := []rune(name); len(runes) > excelize.MaxSheetNameLength {
+		name = string(runes[:excelize.MaxSheetNameLength])
+	}
+
+	unique := name
+	for n := 1; used[strings.ToLower(unique)]; n++ {
+		unique = continuationSheet(name, n)
+	}
+	used[strings.ToLower(unique)] = true
+	return unique
+}
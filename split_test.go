@@ -0,0 +1,76 @@
+package xlsx_utilities
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type employee struct {
+	Name       string
+	Department string
+}
+
+func TestSplitBy(t *testing.T) {
+	excelData, err := FromStruct([]employee{
+		{Name: "Alice", Department: "Engineering"},
+		{Name: "Bob", Department: "Sales/EMEA"},
+		{Name: "Carol", Department: "Engineering"},
+		{Name: "Dave"},
+	})
+	assert.NoError(t, err)
+
+	t.Run("Groups in order of appearance", func(t *testing.T) {
+		groups, err := excelData.SplitBy("Department")
+		assert.NoError(t, err)
+		assert.Len(t, groups, 3)
+
+		assert.Equal(t, "Engineering", groups[0].Value)
+		assert.Equal(t, [][]interface{}{{"Alice", "Engineering"}, {"Carol", "Engineering"}}, groups[0].Rows)
+		assert.Equal(t, "Sales/EMEA", groups[1].Value)
+		assert.Equal(t, blankGroup, groups[2].Value)
+
+		_, err = excelData.SplitBy("Team")
+		assert.EqualError(t, err, "unknown split column 'Team'")
+	})
+
+	t.Run("One sheet per value", func(t *testing.T) {
+		f, err := excelData.SplitToSheets("Department", WithTable("Staff", ""))
+		assert.NoError(t, err)
+		defer f.Close()
+
+		assert.Equal(t, []string{"Engineering", "Sales_EMEA", "(blank)"}, f.GetSheetList())
+
+		rows, err := f.GetRows("Engineering")
+		assert.NoError(t, err)
+		assert.Equal(t, [][]string{{"Name", "Department"}, {"Alice", "Engineering"}, {"Carol", "Engineering"}}, rows)
+
+		tables, err := f.GetTables("Sales_EMEA")
+		assert.NoError(t, err)
+		assert.Equal(t, "Staff_2", tables[0].Name)
+	})
+
+	t.Run("One file per value", func(t *testing.T) {
+		dir := t.TempDir()
+		filename := func(value string) string {
+			return filepath.Join(dir, groupSheet(value, map[string]bool{})+".xlsx")
+		}
+		assert.NoError(t, excelData.SplitToFiles("Department", filename))
+
+		imported, err := FromExcel[employee](filepath.Join(dir, "Sales_EMEA.xlsx"))
+		assert.NoError(t, err)
+		assert.Equal(t, []employee{{Name: "Bob", Department: "Sales/EMEA"}}, imported.ToStruct().Data)
+
+		_, err = os.Stat(filepath.Join(dir, "(blank).xlsx"))
+		assert.NoError(t, err)
+	})
+
+	t.Run("Sheet names", func(t *testing.T) {
+		used := map[string]bool{}
+		assert.Equal(t, "North", groupSheet("North", used))
+		assert.Equal(t, "north (2)", groupSheet("north", used))
+		assert.Equal(t, "(blank)", groupSheet("''", used))
+	})
+}
@@ -0,0 +1,130 @@
+package xlsx_utilities
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+var (
+	valuerType  = reflect.TypeOf((*driver.Valuer)(nil)).Elem()
+	scannerType = reflect.TypeOf((*sql.Scanner)(nil)).Elem()
+)
+
+// valuerConverter writes the driver.Valuer value of t, or nil for NULL, for custom database types
+// beyond the sql.Null* ones. Byte slices are written as text, and values with a registered
+// converter, such as time.Time, are written through it.
+func valuerConverter(t reflect.Type) cellConverter {
+	return func(i interface{}) (interface{}, error) {
+		value, err := addressable(t, i).Interface().(driver.Valuer).Value()
+		if err != nil || value == nil {
+			return nil, err
+		}
+		if b, ok := value.([]byte); ok {
+			return string(b), nil
+		}
+		if converter, ok := TypeConverters[reflect.TypeOf(value)]; ok {
+			return converter(value)
+		}
+		return value, nil
+	}
+}
+
+// scannerParser parses a cell into a value of t using sql.Scanner; blank cells are left at the zero
+// value. The cell text is scanned first, then its numeric or
+// boolean value, so scanners accepting only int64, float64 or bool work too.
+func scannerParser(t reflect.Type) CustomTypeParser {
+	return func(s string) (interface{}, error) {
+		if s == "" {
+			return nil, nil
+		}
+
+		var err error
+		for _, value := range scanValues(s) {
+			v := reflect.New(t)
+			if err = v.Interface().(sql.Scanner).Scan(value); err == nil {
+				return v.Elem().Interface(), nil
+			}
+		}
+		return nil, err
+	}
+}
+
+// scanValues returns the candidate values passed to Scan for the cell text, in order
+func scanValues(s string) []interface{} {
+	values := []interface{}{s}
+	if i, err := strconv.ParseInt(s, 10, 64); err == nil {
+		values = append(values, i)
+	} else if f, err := strconv.ParseFloat(s, 64); err == nil {
+		values = append(values, f)
+	} else if b, err := strconv.ParseBool(s); err == nil {
+		values = append(values, b)
+	}
+	return values
+}
+
+// FromSQLRows reads a query result into ExcelData, using the column names as headers.
+// Byte slices are stored as text. The rows are consumed but not closed.
+func FromSQLRows[T comparable](rows *sql.Rows, opts ...Option) (*ExcelData[T], error) {
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+
+	ed := NewExcelData[T](columns)
+	ed.options = opts
+
+	err = scanSQLRows(rows, len(columns), func(row []interface{}) error {
+		return ed.AddRow(row)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return ed, nil
+}
+
+// WriteSQLRows streams a query result into the writer. The columns must match the writer's headers,
+// in order, or ErrHeaderMismatch is returned. The rows are consumed but not closed.
+func (w *StreamWriter[T]) WriteSQLRows(rows *sql.Rows) error {
+	columns, err := rows.Columns()
+	if err != nil {
+		return err
+	}
+	if !slices.Equal(columns, w.names) {
+		return fmt.Errorf("%w: query columns %s, writer headers %s",
+			ErrHeaderMismatch, strings.Join(columns, ", "), strings.Join(w.names, ", "))
+	}
+
+	return scanSQLRows(rows, len(columns), w.WriteRow)
+}
+
+// scanSQLRows scans every row into a new slice of values and passes it to add
+func scanSQLRows(rows *sql.Rows, columns int, add func([]interface{}) error) error {
+	for rows.Next() {
+		values := make([]interface{}, columns)
+		pointers := make([]interface{}, columns)
+		for i := range values {
+			pointers[i] = &values[i]
+		}
+
+		if err := rows.Scan(pointers...); err != nil {
+			return err
+		}
+		for i, value := range values {
+			if b, ok := value.([]byte); ok {
+				values[i] = string(b)
+			}
+		}
+
+		if err := add(values); err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
@@ -0,0 +1,166 @@
+package xlsx_utilities
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"io"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// status is a database enum stored as an integer
+type status int
+
+func (s status) Value() (driver.Value, error) {
+	return int64(s), nil
+}
+
+func (s *status) Scan(src interface{}) error {
+	i, ok := src.(int64)
+	if !ok {
+		return fmt.Errorf("cannot scan %T into status", src)
+	}
+	*s = status(i)
+	return nil
+}
+
+// code is a database type stored as bytes
+type code struct {
+	value string
+}
+
+func (c code) Value() (driver.Value, error) {
+	return []byte(c.value), nil
+}
+
+func (c *code) Scan(src interface{}) error {
+	s, ok := src.(string)
+	if !ok {
+		return fmt.Errorf("cannot scan %T into code", src)
+	}
+	c.value = s
+	return nil
+}
+
+func TestValuerAndScanner(t *testing.T) {
+	type order struct {
+		Code   code
+		Status status
+	}
+
+	filename := "test_valuer.xlsx"
+	defer os.Remove(filename)
+
+	data := []order{{Code: code{value: "A-1"}, Status: 2}}
+
+	excelData, err := FromStruct(data)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Code", "Status"}, excelData.Headers)
+	assert.Equal(t, []interface{}{"A-1", int64(2)}, excelData.Rows[0])
+
+	assert.NoError(t, excelData.Save(filename))
+
+	imported, err := FromExcel[order](filename)
+	assert.NoError(t, err)
+
+	result := imported.ToStruct()
+	assert.Empty(t, result.Errors)
+	assert.Equal(t, data, result.Data)
+}
+
+// fakeDriver serves a fixed result set for every query
+type fakeDriver struct {
+	columns []string
+	rows    [][]driver.Value
+}
+
+type fakeConn struct{ d *fakeDriver }
+
+type fakeStmt struct{ d *fakeDriver }
+
+type fakeRows struct {
+	d    *fakeDriver
+	next int
+}
+
+func (d *fakeDriver) Open(string) (driver.Conn, error) { return fakeConn{d}, nil }
+func (c fakeConn) Prepare(string) (driver.Stmt, error) { return fakeStmt(c), nil }
+func (c fakeConn) Close() error                        { return nil }
+func (c fakeConn) Begin() (driver.Tx, error)           { return nil, fmt.Errorf("not supported") }
+func (s fakeStmt) Close() error                        { return nil }
+func (s fakeStmt) NumInput() int                       { return -1 }
+func (s fakeStmt) Exec([]driver.Value) (driver.Result, error) {
+	return nil, fmt.Errorf("not supported")
+}
+func (s fakeStmt) Query([]driver.Value) (driver.Rows, error) { return &fakeRows{d: s.d}, nil }
+func (r *fakeRows) Columns() []string                        { return r.d.columns }
+func (r *fakeRows) Close() error                             { return nil }
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if r.next >= len(r.d.rows) {
+		return io.EOF
+	}
+	copy(dest, r.d.rows[r.next])
+	r.next++
+	return nil
+}
+
+func TestFromSQLRows(t *testing.T) {
+	sql.Register("xlsx_fake", &fakeDriver{
+		columns: []string{"name", "age", "note"},
+		rows: [][]driver.Value{
+			{[]byte("Alice"), int64(30), nil},
+			{"Bob", int64(25), "hello"},
+		},
+	})
+
+	db, err := sql.Open("xlsx_fake", "")
+	assert.NoError(t, err)
+	defer db.Close()
+
+	t.Run("Into ExcelData", func(t *testing.T) {
+		rows, err := db.Query("SELECT name, age, note FROM people")
+		assert.NoError(t, err)
+		defer rows.Close()
+
+		excelData, err := FromSQLRows[any](rows)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"name", "age", "note"}, excelData.Headers)
+		assert.Equal(t, [][]interface{}{{"Alice", int64(30), nil}, {"Bob", int64(25), "hello"}}, excelData.Rows)
+	})
+
+	t.Run("Into stream writer", func(t *testing.T) {
+		filename := "test_sql_rows.xlsx"
+		defer os.Remove(filename)
+
+		rows, err := db.Query("SELECT name, age, note FROM people")
+		assert.NoError(t, err)
+		defer rows.Close()
+
+		w, err := NewStreamWriter[any]([]string{"name", "age", "note"})
+		assert.NoError(t, err)
+		assert.NoError(t, w.WriteSQLRows(rows))
+		assert.NoError(t, w.Save(filename))
+
+		imported, err := FromExcel[any](filename)
+		assert.NoError(t, err)
+		assert.Equal(t, []interface{}{"Bob", 25, "hello"}, imported.Rows[1])
+	})
+
+	t.Run("Into stream writer with other headers", func(t *testing.T) {
+		rows, err := db.Query("SELECT name, age, note FROM people")
+		assert.NoError(t, err)
+		defer rows.Close()
+
+		w, err := NewStreamWriter[any]([]string{"name", "note", "age"})
+		assert.NoError(t, err)
+		defer w.f.Close()
+
+		err = w.WriteSQLRows(rows)
+		assert.ErrorIs(t, err, ErrHeaderMismatch)
+		assert.EqualError(t, err, "headers do not match: query columns name, age, note, writer headers name, note, age")
+	})
+}
@@ -0,0 +1,253 @@
+package xlsx_utilities
+
+import (
+	"fmt"
+	"reflect"
+	"runtime/debug"
+	"runtime/metrics"
+
+	"github.com/xuri/excelize/v2"
+)
+
+const (
+	// streamBatchBytes is the estimated size of the rows buffered before they are written
+	streamBatchBytes = 1 << 20
+	// minStreamBatch and maxStreamBatch bound the adaptive batch size
+	minStreamBatch = 16
+	maxStreamBatch = 10000
+)
+
+// WithBatchSize sets the number of rows a StreamWriter buffers before writing them to the sheet.
+// Without it, the batch size adapts to the observed row width and memory pressure.
+func WithBatchSize(rows int) Option {
+	return func(c *config) {
+		c.batchSize = rows
+	}
+}
+
+// StreamWriter writes rows to a sheet one at a time, without keeping the whole dataset in memory.
+// Rows are buffered and written in batches. Only the sheet, anchor, headers, text normalization,
+// locale and row policy options apply; other export features need the complete data and are ignored.
+type StreamWriter[T comparable] struct {
+	f       *excelize.File
+	sw      *excelize.StreamWriter
+	cfg     *config
+	names   []string // the headers as given, before labels are applied
+	headers []interface{}
+	sheets  int // continuation sheets started after the sheet filled up
+	layout  sheetLayout
+	batch   [][]interface{}
+	size    int // rows buffered before the batch is written
+	written int
+
+	// observed width of the written rows, used to size the batches
+	rowBytes int
+	rowCount int
+}
+
+// NewStreamWriter creates a workbook and starts streaming to its configured sheet.
+// When headers is nil, they are derived from the fields of T as in FromStruct.
+func NewStreamWriter[T comparable](headers []string, opts ...Option) (*StreamWriter[T], error) {
+	cfg := newConfig(opts)
+
+	if headers == nil {
+		var err error
+		if headers, err = getStructHeaders(reflect.TypeOf((*T)(nil)).Elem()); err != nil {
+			return nil, fmt.Errorf("error getting headers: %w", err)
+		}
+	}
+
+	col, row, err := excelize.CellNameToCoordinates(cfg.anchor)
+	if err != nil {
+		return nil, fmt.Errorf("invalid anchor cell '%s': %w", cfg.anchor, err)
+	}
+
+	f, err := openWorkbook(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	sw, err := f.NewStreamWriter(cfg.sheet)
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+
+	values := make([]interface{}, len(headers))
+	for i, header := range headerLabels(reflect.TypeOf((*T)(nil)).Elem(), headers, cfg) {
+		values[i] = header
+	}
+
+	w := &StreamWriter[T]{
+		f:       f,
+		sw:      sw,
+		cfg:     cfg,
+		names:   headers,
+		headers: values,
+		layout: sheetLayout{
+			Sheet:     cfg.sheet,
+			Col:       col,
+			Row:       row,
+			HeaderRow: !cfg.skipHeaders,
+			Cols:      len(headers),
+		},
+	}
+	w.size = w.batchSize()
+
+	if w.layout.HeaderRow {
+		if err := sw.SetRow(w.layout.cell(0, row), values); err != nil {
+			f.Close()
+			return nil, err
+		}
+	}
+
+	return w, nil
+}
+
+// WriteRow buffers a row of values in header order, writing the batch when it is full
+func (w *StreamWriter[T]) WriteRow(row []interface{}) error {
+	if len(row) != w.layout.Cols {
+		return fmt.Errorf("row length (%d) does not match headers length (%d)", len(row), w.layout.Cols)
+	}
+
+	w.batch = append(w.batch, row)
+	if len(w.batch) >= w.size {
+		return w.Flush()
+	}
+	return nil
+}
+
+// WriteStruct buffers the values of an item, flattened as in FromStruct
+func (w *StreamWriter[T]) WriteStruct(item T) error {
+	row, err := getStructValues(reflect.ValueOf(item))
+	if err != nil {
+		return fmt.Errorf("error getting values: %w", err)
+	}
+	return w.WriteRow(row)
+}
+
+// Flush writes the buffered rows to the sheet
+func (w *StreamWriter[T]) Flush() error {
+	for _, row := range w.batch {
+		values := make([]interface{}, len(row))
+		for i, value := range row {
+			value = exportText(value, w.cfg)
+			values[i] = localizeValue(value, w.cfg)
+			w.rowBytes += estimateSize(value)
+		}
+		w.rowCount++
+
+		if w.layout.firstDataRow()+w.written > excelize.TotalRows {
+			if err := w.continueSheet(); err != nil {
+				return err
+			}
+		}
+		if err := w.sw.SetRow(w.layout.cell(0, w.layout.firstDataRow()+w.written), values); err != nil {
+			return err
+		}
+		w.written++
+	}
+
+	w.batch = w.batch[:0]
+	w.size = w.batchSize()
+	return nil
+}
+
+// continueSheet finishes the full sheet and continues on the next sheet named by continuationSheet,
+// starting with the header row, or fails under RowError
+func (w *StreamWriter[T]) continueSheet() error {
+	if w.cfg.rowPolicy == RowError {
+		return fmt.Errorf("too many rows (the sheet fits %d)", w.written)
+	}
+	if err := w.sw.Flush(); err != nil {
+		return err
+	}
+
+	w.sheets++
+	sheet := continuationSheet(w.cfg.sheet, w.sheets)
+	if err := ensureSheet(w.f, sheet); err != nil {
+		return err
+	}
+	sw, err := w.f.NewStreamWriter(sheet)
+	if err != nil {
+		return err
+	}
+
+	w.sw = sw
+	w.layout.Sheet, w.layout.Row, w.layout.HeaderRow = sheet, 1, true
+	w.written = 0
+	return w.sw.SetRow(w.layout.cell(0, 1), w.headers)
+}
+
+// Close writes the remaining rows and finishes the sheet. The workbook stays open for saving.
+func (w *StreamWriter[T]) Close() error {
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	return w.sw.Flush()
+}
+
+// File returns the workbook being written; call Close before saving it
+func (w *StreamWriter[T]) File() *excelize.File {
+	return w.f
+}
+
+// Save closes the stream, saves the workbook and releases it
+func (w *StreamWriter[T]) Save(filename string) error {
+	defer w.f.Close()
+
+	if err := w.Close(); err != nil {
+		return err
+	}
+	return saveWorkbook(w.f, filename, w.cfg)
+}
+
+// batchSize returns the configured batch size, or one sized to about streamBatchBytes of rows
+// based on the average width of the rows written so far, halved while the heap is above half
+// of the Go memory limit
+func (w *StreamWriter[T]) batchSize() int {
+	if w.cfg.batchSize > 0 {
+		return w.cfg.batchSize
+	}
+	if w.rowCount == 0 {
+		return minStreamBatch
+	}
+
+	size := streamBatchBytes / (w.rowBytes/w.rowCount + 1)
+	if memoryPressure() {
+		size /= 2
+	}
+
+	if size < minStreamBatch {
+		return minStreamBatch
+	}
+	if size > maxStreamBatch {
+		return maxStreamBatch
+	}
+	return size
+}
+
+// memoryPressure reports whether the heap uses more than half of the Go memory limit
+func memoryPressure() bool {
+	limit := debug.SetMemoryLimit(-1)
+
+	sample := []metrics.Sample{{Name: "/memory/classes/heap/objects:bytes"}}
+	metrics.Read(sample)
+	if sample[0].Value.Kind() != metrics.KindUint64 {
+		return false
+	}
+
+	return sample[0].Value.Uint64() > uint64(limit)/2
+}
+
+// estimateSize returns the approximate number of bytes a value takes in the sheet
+func estimateSize(value interface{}) int {
+	switch v := value.(type) {
+	case string:
+		return len(v) + 8
+	case nil:
+		return 0
+	default:
+		return 16
+	}
+}
@@ -0,0 +1,60 @@
+package xlsx_utilities
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStreamWriter(t *testing.T) {
+	filename := "test_stream.xlsx"
+	defer os.Remove(filename)
+
+	t.Run("Streams structs", func(t *testing.T) {
+		w, err := NewStreamWriter[person](nil, WithSheet("People"), WithBatchSize(2))
+		assert.NoError(t, err)
+
+		for i := 0; i < 5; i++ {
+			assert.NoError(t, w.WriteStruct(person{Name: fmt.Sprintf("P%d", i), Age: 20 + i}))
+		}
+		assert.Len(t, w.batch, 1)
+		assert.NoError(t, w.Save(filename))
+
+		imported, err := FromExcel[person](filename, WithSheet("People"))
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"Name", "Age"}, imported.Headers)
+		assert.Len(t, imported.Rows, 5)
+		assert.Equal(t, []interface{}{"P4", 24}, imported.Rows[4])
+	})
+
+	t.Run("Row length is checked", func(t *testing.T) {
+		w, err := NewStreamWriter[person]([]string{"Name"})
+		assert.NoError(t, err)
+		defer w.File().Close()
+
+		assert.Error(t, w.WriteRow([]interface{}{"a", "b"}))
+	})
+
+	t.Run("Adaptive batch size", func(t *testing.T) {
+		w, err := NewStreamWriter[person]([]string{"Text"})
+		assert.NoError(t, err)
+		defer w.File().Close()
+
+		assert.Equal(t, minStreamBatch, w.size)
+
+		narrow := w.size
+		for i := 0; i < narrow; i++ {
+			assert.NoError(t, w.WriteRow([]interface{}{"x"}))
+		}
+		assert.Greater(t, w.size, narrow)
+
+		adapted := w.size
+		assert.NoError(t, w.WriteRow([]interface{}{strings.Repeat("x", 1<<20)}))
+		assert.NoError(t, w.Flush())
+		assert.Less(t, w.size, adapted)
+		assert.NoError(t, w.Close())
+	})
+}
@@ -0,0 +1,52 @@
+package xlsx_utilities
+
+import "context"
+
+// ToStructStream converts the rows in a goroutine and delivers each record, import error and warning as soon as its row
+// is converted, so processing can start before all rows are converted. The channels are closed when the conversion is
+// done or ctx is cancelled; until then the caller must keep receiving from all three, e.g. in a select loop, or cancel ctx
+// to stop early.
+func (ed *ExcelData[T]) ToStructStream(ctx context.Context) (<-chan T, <-chan ImportError, <-chan ImportWarning) {
+	data := make(chan T)
+	errs := make(chan ImportError)
+	warnings := make(chan ImportWarning)
+
+	conv := ed.newRowConverter(ed.config())
+	rows := ed.Rows
+
+	go func() {
+		defer close(data)
+		defer close(errs)
+		defer close(warnings)
+
+		conv.convertRows(rows, func(row convertedRow[T]) bool {
+			if row.kept && !send(ctx, data, row.item) {
+				return false
+			}
+			for _, err := range row.errors {
+				if !send(ctx, errs, err) {
+					return false
+				}
+			}
+			for _, warning := range row.warnings {
+				if !send(ctx, warnings, warning) {
+					return false
+				}
+			}
+			// stop before converting the next row once ctx is cancelled
+			return ctx.Err() == nil
+		})
+	}()
+
+	return data, errs, warnings
+}
+
+// send delivers v on ch, reporting false when ctx is cancelled first
+func send[V any](ctx context.Context, ch chan<- V, v V) bool {
+	select {
+	case ch <- v:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
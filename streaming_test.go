@@ -0,0 +1,74 @@
+package xlsx_utilities
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToStructStream(t *testing.T) {
+	excelData := NewExcelData[person]([]string{"Name", "Age"})
+	excelData.AddRow([]interface{}{"Alice", 30})
+	excelData.AddRow([]interface{}{"Bob", 25})
+	excelData.AddRow([]interface{}{"Carol", " 41 "})
+	excelData.WithOptions(WithAfterReadRow(func(rowIndex int, item *person) error {
+		if item.Name == "Bob" {
+			return assert.AnError
+		}
+		return nil
+	}))
+
+	data, errs, warnings := excelData.ToStructStream(context.Background())
+
+	var people []person
+	var importErrors []ImportError
+	var importWarnings []ImportWarning
+	for data != nil || errs != nil || warnings != nil {
+		select {
+		case p, ok := <-data:
+			if !ok {
+				data = nil
+				continue
+			}
+			people = append(people, p)
+		case err, ok := <-errs:
+			if !ok {
+				errs = nil
+				continue
+			}
+			importErrors = append(importErrors, err)
+		case warning, ok := <-warnings:
+			if !ok {
+				warnings = nil
+				continue
+			}
+			importWarnings = append(importWarnings, warning)
+		}
+	}
+
+	assert.Equal(t, []person{{"Alice", 30}, {"Carol", 41}}, people)
+	assert.Equal(t, []ImportError{{RowIndex: 3, Err: assert.AnError}}, importErrors)
+	assert.Len(t, importWarnings, 1)
+	assert.Equal(t, "Age", importWarnings[0].Header)
+
+	t.Run("Cancelled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		data, errs, warnings := excelData.ToStructStream(ctx)
+
+		assert.Equal(t, person{"Alice", 30}, <-data)
+		cancel()
+
+		// the conversion stops and closes the channels without further receives
+		_, open := <-errs
+		for open {
+			_, open = <-errs
+		}
+		_, open = <-warnings
+		for open {
+			_, open = <-warnings
+		}
+		for range data {
+		}
+	})
+}
@@ -0,0 +1,63 @@
+package xlsx_utilities
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// WithStrictTypes disables the implicit coercion between cell and field types when reading:
+// a number or boolean cell feeding a string field, or a text cell feeding a number or boolean
+// field, is reported as an ImportError wrapping ErrTypeMismatch instead of being stringified or
+// left at the zero value. Texts read as booleans, such as "yes" or WithBoolSynonyms, still feed
+// bool fields, and texts parsed by the WithNumberStyle of the column, or read with WithRawStrings,
+// feed number fields when they are numbers; empty cells, enum-mapped, JSON and custom type fields
+// are not checked.
+func WithStrictTypes() Option {
+	return func(c *config) {
+		c.strictTypes = true
+	}
+}
+
+// checkStrictType returns a ConversionError when the kind of the cell value does not match the field of the plan.
+// With numericText, a text holding a number matches number fields.
+func checkStrictType(plan *fieldPlan, value interface{}, numericText bool) error {
+	target := plan.target()
+	if target == nil || plan.enum != nil || plan.json || isEmptyCell(value) {
+		return nil
+	}
+	if _, ok := parserFor(target); ok {
+		return nil
+	}
+
+	want, got := kindClass(target.Kind()), kindClass(reflect.TypeOf(value).Kind())
+	if want == reflect.Invalid || want == got {
+		return nil
+	}
+	if text, ok := value.(string); ok && want == reflect.Bool {
+		// bool synonyms are replaced by "true" or "false" before the check
+		if _, err := strconv.ParseBool(text); err == nil {
+			return nil
+		}
+	}
+	if text, ok := value.(string); ok && want == reflect.Float64 && numericText {
+		if _, err := strconv.ParseFloat(strings.TrimSpace(text), 64); err == nil {
+			return nil
+		}
+	}
+	return &ConversionError{Value: value, Type: target, Err: ErrTypeMismatch}
+}
+
+// kindClass groups the kinds a cell value can be converted to without coercion:
+// strings, numbers and booleans. Other kinds return reflect.Invalid.
+func kindClass(kind reflect.Kind) reflect.Kind {
+	switch kind {
+	case reflect.String, reflect.Bool:
+		return kind
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return reflect.Float64
+	}
+	return reflect.Invalid
+}
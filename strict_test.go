@@ -0,0 +1,67 @@
+package xlsx_utilities
+
+import (
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStrictTypes(t *testing.T) {
+	type account struct {
+		Code    string
+		Balance float64
+		Active  bool
+	}
+
+	excelData := NewExcelData[account]([]string{"Code", "Balance", "Active"})
+	excelData.AddRow([]interface{}{"A-1", 12, true})
+	excelData.AddRow([]interface{}{1001, "lots", 1})
+	excelData.AddRow([]interface{}{"A-3", "", "ja"})
+
+	t.Run("Coerced", func(t *testing.T) {
+		result := excelData.ToStruct()
+		assert.Len(t, result.Data, 3)
+		assert.Equal(t, "1001", result.Data[1].Code)
+	})
+
+	t.Run("Strict", func(t *testing.T) {
+		result := excelData.WithOptions(WithStrictTypes(), WithBoolSynonyms(map[string]bool{"ja": true})).ToStruct()
+		assert.Equal(t, []account{{"A-1", 12, true}, {"A-3", 0, true}}, result.Data)
+
+		var headers []string
+		for _, err := range result.Errors {
+			headers = append(headers, err.Header)
+			assert.True(t, errors.Is(err, ErrTypeMismatch))
+		}
+		assert.Equal(t, []string{"Code", "Balance", "Active"}, headers)
+	})
+
+	t.Run("Number styles", func(t *testing.T) {
+		rates := NewExcelData[account]([]string{"Code", "Balance"})
+		rates.AddRow([]interface{}{"A-1", "45%"})
+		rates.AddRow([]interface{}{"A-2", "lots"})
+
+		result := rates.WithOptions(WithStrictTypes(), WithNumberStyle(NumberStyle{Percent: true}, "Balance")).ToStruct()
+		assert.Equal(t, []account{{Code: "A-1", Balance: 0.45}}, result.Data)
+		assert.Len(t, result.Errors, 1)
+	})
+
+	t.Run("Raw strings", func(t *testing.T) {
+		filename := "test_strict_raw.xlsx"
+		defer os.Remove(filename)
+		assert.NoError(t, excelData.Save(filename))
+
+		imported, err := FromExcel[account](filename, WithRawStrings(), WithStrictTypes())
+		assert.NoError(t, err)
+		result := imported.ToStruct()
+		assert.Equal(t, []account{{"A-1", 12, true}}, result.Data)
+
+		var headers []string
+		for _, err := range result.Errors {
+			headers = append(headers, err.Header)
+		}
+		assert.Equal(t, []string{"Balance", "Active"}, headers)
+	})
+}
@@ -0,0 +1,305 @@
+package xlsx_utilities
+
+import (
+	"fmt"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// Style describes the look of a cell. Zero-valued fields inherit from the enclosing level.
+type Style struct {
+	Bold        bool    `json:"bold,omitempty" yaml:"bold,omitempty"`
+	Italic      bool    `json:"italic,omitempty" yaml:"italic,omitempty"`
+	FontFamily  string  `json:"fontFamily,omitempty" yaml:"fontFamily,omitempty"`
+	FontSize    float64 `json:"fontSize,omitempty" yaml:"fontSize,omitempty"`
+	FontColor   string  `json:"fontColor,omitempty" yaml:"fontColor,omitempty"`
+	FillColor   string  `json:"fillColor,omitempty" yaml:"fillColor,omitempty"`
+	NumFmt      string  `json:"numFmt,omitempty" yaml:"numFmt,omitempty"`
+	HAlign      string  `json:"hAlign,omitempty" yaml:"hAlign,omitempty"`
+	VAlign      string  `json:"vAlign,omitempty" yaml:"vAlign,omitempty"`
+	WrapText    bool    `json:"wrapText,omitempty" yaml:"wrapText,omitempty"`
+	BorderColor string  `json:"borderColor,omitempty" yaml:"borderColor,omitempty"`
+}
+
+// StyleSheet is a cascading style model. Each cell resolves its style from
+// Workbook → Sheet → Column → Row (data cells) or Header (header cells),
+// where every level only overrides the fields it sets.
+type StyleSheet struct {
+	Workbook *Style
+	Sheet    *Style
+	Header   *Style
+	Columns  map[string]*Style
+	// Row returns the style of a data row, e.g. to highlight rows based on their values
+	Row func(rowIndex int, row []interface{}) *Style
+}
+
+// WithStyles applies a cascading style sheet to the export
+func WithStyles(styles StyleSheet) Option {
+	return func(c *config) {
+		c.styles = &styles
+	}
+}
+
+// rowStyler is a type-erased WithRowStyle callback receiving a T
+type rowStyler func(item interface{}, rowIndex int) (*Style, error)
+
+// WithRowStyle styles the data rows of the export from the records they hold, e.g. to color overdue
+// invoices red. The record is converted back from the cells of the row, leaving fields that cannot
+// be imported at their zero value. The returned style applies on top of the style sheet; nil keeps it.
+func WithRowStyle[T comparable](style func(item T, rowIndex int) *Style) Option {
+	return func(c *config) {
+		c.rowStyles = append(c.rowStyles, func(item interface{}, rowIndex int) (*Style, error) {
+			typed, ok := item.(T)
+			if !ok {
+				return nil, fmt.Errorf("row style expects %T, got %T", typed, item)
+			}
+			return style(typed, rowIndex), nil
+		})
+	}
+}
+
+// itemStyles returns the WithRowStyle style of every data row, or nil when there are no row styles
+func (ed *ExcelData[T]) itemStyles(cfg *config) ([]*Style, error) {
+	if len(cfg.rowStyles) == 0 {
+		return nil, nil
+	}
+
+	// the rows are only read back to be styled, so import hooks do not run
+	convCfg := *cfg
+	convCfg.afterRead = nil
+	conv := ed.newRowConverter(&convCfg)
+
+	styles := make([]*Style, len(ed.Rows))
+	for rowIndex, row := range ed.Rows {
+		item, _, _ := conv.convert(rowIndex, row)
+		for _, styler := range cfg.rowStyles {
+			style, err := styler(item, rowIndex)
+			if err != nil {
+				return nil, err
+			}
+			styles[rowIndex] = overlay(styles[rowIndex], style)
+		}
+	}
+	return styles, nil
+}
+
+// merge returns the style with the set fields of o applied on top
+func (s Style) merge(o *Style) Style {
+	if o == nil {
+		return s
+	}
+	if o.Bold {
+		s.Bold = true
+	}
+	if o.Italic {
+		s.Italic = true
+	}
+	if o.FontFamily != "" {
+		s.FontFamily = o.FontFamily
+	}
+	if o.FontSize != 0 {
+		s.FontSize = o.FontSize
+	}
+	if o.FontColor != "" {
+		s.FontColor = o.FontColor
+	}
+	if o.FillColor != "" {
+		s.FillColor = o.FillColor
+	}
+	if o.NumFmt != "" {
+		s.NumFmt = o.NumFmt
+	}
+	if o.HAlign != "" {
+		s.HAlign = o.HAlign
+	}
+	if o.VAlign != "" {
+		s.VAlign = o.VAlign
+	}
+	if o.WrapText {
+		s.WrapText = true
+	}
+	if o.BorderColor != "" {
+		s.BorderColor = o.BorderColor
+	}
+	return s
+}
+
+// toExcelize converts the style to its excelize representation
+func (s Style) toExcelize() *excelize.Style {
+	style := &excelize.Style{
+		Font: &excelize.Font{
+			Bold:   s.Bold,
+			Italic: s.Italic,
+			Family: s.FontFamily,
+			Size:   s.FontSize,
+			Color:  s.FontColor,
+		},
+	}
+
+	if s.FillColor != "" {
+		style.Fill = excelize.Fill{Type: "pattern", Pattern: 1, Color: []string{s.FillColor}}
+	}
+
+	if s.NumFmt != "" {
+		numFmt := s.NumFmt
+		style.CustomNumFmt = &numFmt
+	}
+
+	if s.HAlign != "" || s.VAlign != "" || s.WrapText {
+		style.Alignment = &excelize.Alignment{
+			Horizontal: s.HAlign,
+			Vertical:   s.VAlign,
+			WrapText:   s.WrapText,
+		}
+	}
+
+	if s.BorderColor != "" {
+		for _, side := range []string{"left", "top", "right", "bottom"} {
+			style.Border = append(style.Border, excelize.Border{Type: side, Color: s.BorderColor, Style: 1})
+		}
+	}
+
+	return style
+}
+
+// styleCache resolves styles into excelize style IDs once per distinct style
+type styleCache struct {
+	f   *excelize.File
+	ids map[Style]int
+}
+
+func newStyleCache(f *excelize.File) *styleCache {
+	return &styleCache{f: f, ids: make(map[Style]int)}
+}
+
+// id returns the excelize style ID for the style, creating it on first use
+func (c *styleCache) id(s Style) (int, error) {
+	if s == (Style{}) {
+		return 0, nil
+	}
+	if id, ok := c.ids[s]; ok {
+		return id, nil
+	}
+	id, err := c.f.NewStyle(s.toExcelize())
+	if err != nil {
+		return 0, err
+	}
+	c.ids[s] = id
+	return id, nil
+}
+
+// restyler modifies the existing style of cells, creating each derived style once
+type restyler struct {
+	f       *excelize.File
+	sheet   string
+	modify  func(*excelize.Style)
+	derived map[int]int
+}
+
+func newRestyler(f *excelize.File, sheet string, modify func(*excelize.Style)) *restyler {
+	return &restyler{f: f, sheet: sheet, modify: modify, derived: make(map[int]int)}
+}
+
+// restyle replaces the style of the cell with its modified copy
+func (r *restyler) restyle(cell string) error {
+	current, err := r.f.GetCellStyle(r.sheet, cell)
+	if err != nil {
+		return err
+	}
+
+	id, ok := r.derived[current]
+	if !ok {
+		style, err := r.f.GetStyle(current)
+		if err != nil {
+			return err
+		}
+		r.modify(style)
+		if id, err = r.f.NewStyle(style); err != nil {
+			return err
+		}
+		r.derived[current] = id
+	}
+
+	return r.f.SetCellStyle(r.sheet, cell, cell, id)
+}
+
+// applyStyles resolves the style sheet for every written cell and sets the resulting style IDs.
+// itemStyles holds the WithRowStyle style of each row, applied on top of the style sheet.
+func applyStyles(f *excelize.File, layout sheetLayout, cfg *config, headers []string, rows [][]interface{}, itemStyles []*Style) error {
+	ss, err := cfg.styleSheet()
+	if err != nil {
+		return err
+	}
+	if ss == nil && itemStyles == nil {
+		return nil
+	}
+	if ss == nil {
+		ss = &StyleSheet{}
+	}
+	cache := newStyleCache(f)
+	base := Style{}.merge(ss.Workbook).merge(ss.Sheet)
+
+	columns := make([]Style, len(headers))
+	for i, header := range headers {
+		columns[i] = base.merge(ss.Columns[header])
+	}
+
+	ids := make([]int, len(headers))
+
+	if layout.HeaderRow {
+		for i := range headers {
+			id, err := cache.id(columns[i].merge(ss.Header))
+			if err != nil {
+				return err
+			}
+			ids[i] = id
+		}
+		if err := setRowStyles(f, layout, layout.Row, ids); err != nil {
+			return err
+		}
+		if layout.GroupRow {
+			if err := setRowStyles(f, layout, layout.Row-1, ids); err != nil {
+				return err
+			}
+		}
+	}
+
+	for rowIndex, row := range rows {
+		var rowStyle *Style
+		if ss.Row != nil {
+			rowStyle = ss.Row(rowIndex, row)
+		}
+		if itemStyles != nil {
+			rowStyle = overlay(rowStyle, itemStyles[rowIndex])
+		}
+		for i := range headers {
+			id, err := cache.id(columns[i].merge(rowStyle))
+			if err != nil {
+				return err
+			}
+			ids[i] = id
+		}
+		if err := setRowStyles(f, layout, layout.firstDataRow()+rowIndex, ids); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// setRowStyles sets the style IDs of one row, grouping consecutive equal IDs into a single range
+func setRowStyles(f *excelize.File, layout sheetLayout, row int, ids []int) error {
+	start := 0
+	for i := 1; i <= len(ids); i++ {
+		if i < len(ids) && ids[i] == ids[start] {
+			continue
+		}
+		if ids[start] != 0 {
+			if err := f.SetCellStyle(layout.Sheet, layout.cell(start, row), layout.cell(i-1, row), ids[start]); err != nil {
+				return fmt.Errorf("error styling row %d: %w", row, err)
+			}
+		}
+		start = i
+	}
+	return nil
+}
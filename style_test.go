@@ -0,0 +1,107 @@
+package xlsx_utilities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCascadingStyles(t *testing.T) {
+	data := []person{{Name: "Alice", Age: 30}, {Name: "Bob", Age: 17}, {Name: "Charlie", Age: 35}}
+	excelData, err := FromStruct(data)
+	assert.NoError(t, err)
+
+	styles := StyleSheet{
+		Workbook: &Style{FontFamily: "Arial"},
+		Header:   &Style{Bold: true, FillColor: "DDDDDD"},
+		Columns: map[string]*Style{
+			"Age": {HAlign: "right"},
+		},
+		Row: func(rowIndex int, row []interface{}) *Style {
+			if age, ok := row[1].(int); ok && age < 18 {
+				return &Style{FontColor: "FF0000"}
+			}
+			return nil
+		},
+	}
+
+	f, err := excelData.ToWorkbook(WithStyles(styles))
+	assert.NoError(t, err)
+	defer f.Close()
+
+	id := func(cell string) int {
+		style, err := f.GetCellStyle("Sheet1", cell)
+		assert.NoError(t, err)
+		return style
+	}
+
+	t.Run("Header resolves on top of workbook style", func(t *testing.T) {
+		style, err := f.GetStyle(id("A1"))
+		assert.NoError(t, err)
+		assert.True(t, style.Font.Bold)
+		assert.Equal(t, "Arial", style.Font.Family)
+		assert.Equal(t, []string{"DDDDDD"}, style.Fill.Color)
+	})
+
+	t.Run("Column and row levels", func(t *testing.T) {
+		style, err := f.GetStyle(id("B3"))
+		assert.NoError(t, err)
+		assert.Equal(t, "right", style.Alignment.Horizontal)
+		assert.Equal(t, "FF0000", style.Font.Color)
+		assert.False(t, style.Font.Bold)
+	})
+
+	t.Run("Identical resolved styles share one ID", func(t *testing.T) {
+		assert.Equal(t, id("A2"), id("A4"))
+		assert.Equal(t, id("B2"), id("B4"))
+		assert.NotEqual(t, id("B2"), id("B3"))
+		assert.NotEqual(t, id("A2"), id("B2"))
+	})
+}
+
+func TestRowStyle(t *testing.T) {
+	data := []person{{Name: "Alice", Age: 30}, {Name: "Bob", Age: 17}, {Name: "Charlie", Age: 35}}
+	excelData, err := FromStruct(data)
+	assert.NoError(t, err)
+
+	minors := WithRowStyle(func(p person, rowIndex int) *Style {
+		if p.Age < 18 {
+			return &Style{FillColor: "FFC7CE"}
+		}
+		return nil
+	})
+
+	t.Run("Without a style sheet", func(t *testing.T) {
+		f, err := excelData.ToWorkbook(minors)
+		assert.NoError(t, err)
+		defer f.Close()
+
+		id, err := f.GetCellStyle("Sheet1", "A2")
+		assert.NoError(t, err)
+		assert.Zero(t, id)
+
+		id, err = f.GetCellStyle("Sheet1", "B3")
+		assert.NoError(t, err)
+		style, err := f.GetStyle(id)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"FFC7CE"}, style.Fill.Color)
+	})
+
+	t.Run("On top of the style sheet", func(t *testing.T) {
+		f, err := excelData.ToWorkbook(WithStyles(StyleSheet{Columns: map[string]*Style{"Age": {HAlign: "right"}}}), minors)
+		assert.NoError(t, err)
+		defer f.Close()
+
+		id, err := f.GetCellStyle("Sheet1", "B3")
+		assert.NoError(t, err)
+		style, err := f.GetStyle(id)
+		assert.NoError(t, err)
+		assert.Equal(t, "right", style.Alignment.Horizontal)
+		assert.Equal(t, []string{"FFC7CE"}, style.Fill.Color)
+	})
+
+	t.Run("Mismatched type", func(t *testing.T) {
+		_, err := excelData.ToWorkbook(WithRowStyle(func(item string, rowIndex int) *Style { return nil }))
+		assert.ErrorContains(t, err, "row style expects string, got xlsx_utilities.person")
+	})
+}
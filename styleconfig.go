@@ -0,0 +1,139 @@
+package xlsx_utilities
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/xuri/excelize/v2"
+	"gopkg.in/yaml.v3"
+)
+
+// StyleConfig is a style definition kept outside the code, e.g. in a JSON or YAML file
+// maintained by designers, and applied to exports with WithStyleConfig:
+//
+//	theme: report
+//	colors:
+//	  brand: 1F4E78
+//	header: {bold: true, fillColor: $brand, fontColor: FFFFFF}
+//	columns:
+//	  Amount: {numFmt: "#,##0.00"}
+//	widths:
+//	  Name: 30
+//
+// Color fields may refer to an entry of Colors as $name.
+type StyleConfig struct {
+	Theme    string             `json:"theme,omitempty" yaml:"theme,omitempty"`
+	Colors   map[string]string  `json:"colors,omitempty" yaml:"colors,omitempty"`
+	Workbook *Style             `json:"workbook,omitempty" yaml:"workbook,omitempty"`
+	Sheet    *Style             `json:"sheet,omitempty" yaml:"sheet,omitempty"`
+	Header   *Style             `json:"header,omitempty" yaml:"header,omitempty"`
+	Columns  map[string]*Style  `json:"columns,omitempty" yaml:"columns,omitempty"`
+	Widths   map[string]float64 `json:"widths,omitempty" yaml:"widths,omitempty"`
+}
+
+// LoadStyleConfig reads a style definition from a .json, .yaml or .yml file
+func LoadStyleConfig(filename string) (*StyleConfig, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	var sc StyleConfig
+	switch strings.ToLower(filepath.Ext(filename)) {
+	case ".json":
+		err = json.Unmarshal(data, &sc)
+	case ".yaml", ".yml":
+		err = yaml.Unmarshal(data, &sc)
+	default:
+		return nil, fmt.Errorf("unsupported style config format '%s'", filepath.Ext(filename))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error parsing style config %s: %w", filename, err)
+	}
+
+	if err := sc.resolveColors(); err != nil {
+		return nil, fmt.Errorf("error in style config %s: %w", filename, err)
+	}
+	return &sc, nil
+}
+
+// resolveColors replaces $name color references with the colors they name
+func (sc *StyleConfig) resolveColors() error {
+	resolve := func(color *string) error {
+		name, ok := strings.CutPrefix(*color, "$")
+		if !ok {
+			return nil
+		}
+		value, ok := sc.Colors[name]
+		if !ok {
+			return fmt.Errorf("unknown color '%s'", *color)
+		}
+		*color = value
+		return nil
+	}
+
+	styles := []*Style{sc.Workbook, sc.Sheet, sc.Header}
+	for _, style := range sc.Columns {
+		styles = append(styles, style)
+	}
+	for _, style := range styles {
+		if style == nil {
+			continue
+		}
+		for _, color := range []*string{&style.FontColor, &style.FillColor, &style.BorderColor} {
+			if err := resolve(color); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// WithStyleConfig applies a style definition, usually loaded with LoadStyleConfig, to the export
+func WithStyleConfig(sc *StyleConfig) Option {
+	return func(c *config) {
+		if sc.Theme != "" {
+			c.theme = sc.Theme
+		}
+		if sc.Workbook != nil || sc.Sheet != nil || sc.Header != nil || len(sc.Columns) > 0 {
+			WithStyles(StyleSheet{Workbook: sc.Workbook, Sheet: sc.Sheet, Header: sc.Header, Columns: sc.Columns})(c)
+		}
+		if len(sc.Widths) > 0 {
+			WithColumnWidths(sc.Widths)(c)
+		}
+	}
+}
+
+// WithColumnWidths sets the width of columns of the export by header, in characters.
+// Headers not in the export are ignored, so one definition can serve several reports.
+func WithColumnWidths(widths map[string]float64) Option {
+	return func(c *config) {
+		if c.columnWidths == nil {
+			c.columnWidths = make(map[string]float64)
+		}
+		for header, width := range widths {
+			c.columnWidths[header] = width
+		}
+	}
+}
+
+// applyColumnWidths sets the configured widths of the exported columns; transposed exports have no column per header
+func applyColumnWidths(f *excelize.File, layout sheetLayout, cfg *config, headers []string) error {
+	if len(cfg.columnWidths) == 0 || layout.Transposed {
+		return nil
+	}
+	for i, header := range headers {
+		width, ok := cfg.columnWidths[header]
+		if !ok {
+			continue
+		}
+		col := intToExcelColumn(layout.Col - 1 + i)
+		if err := f.SetColWidth(layout.Sheet, col, col, width); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+package xlsx_utilities
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStyleConfig(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, content string) string {
+		filename := filepath.Join(dir, name)
+		assert.NoError(t, os.WriteFile(filename, []byte(content), 0o644))
+		return filename
+	}
+
+	excelData, err := FromStruct([]person{{Name: "Alice", Age: 30}, {Name: "Bob", Age: 17}})
+	assert.NoError(t, err)
+
+	t.Run("YAML", func(t *testing.T) {
+		sc, err := LoadStyleConfig(write("report.yaml", `
+theme: striped
+colors:
+  brand: 1F4E78
+header: {fontColor: $brand}
+columns:
+  Age: {hAlign: right, numFmt: "0"}
+widths:
+  Name: 30
+  Missing: 10
+`))
+		assert.NoError(t, err)
+		assert.Equal(t, "1F4E78", sc.Header.FontColor)
+
+		f, err := excelData.ToWorkbook(WithStyleConfig(sc))
+		assert.NoError(t, err)
+		defer f.Close()
+
+		width, err := f.GetColWidth("Sheet1", "A")
+		assert.NoError(t, err)
+		assert.Equal(t, 30.0, width)
+
+		id, err := f.GetCellStyle("Sheet1", "A1")
+		assert.NoError(t, err)
+		header, err := f.GetStyle(id)
+		assert.NoError(t, err)
+		assert.Equal(t, "1F4E78", header.Font.Color)
+		assert.Equal(t, []string{"D9E1F2"}, header.Fill.Color)
+
+		id, err = f.GetCellStyle("Sheet1", "B3")
+		assert.NoError(t, err)
+		age, err := f.GetStyle(id)
+		assert.NoError(t, err)
+		assert.Equal(t, "right", age.Alignment.Horizontal)
+		assert.Equal(t, []string{"F2F2F2"}, age.Fill.Color)
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		sc, err := LoadStyleConfig(write("report.json", `{"header": {"bold": true, "fillColor": "262626"}, "widths": {"Age": 8}}`))
+		assert.NoError(t, err)
+		assert.Equal(t, &StyleConfig{Header: &Style{Bold: true, FillColor: "262626"}, Widths: map[string]float64{"Age": 8}}, sc)
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		_, err := LoadStyleConfig(write("report.toml", ""))
+		assert.EqualError(t, err, "unsupported style config format '.toml'")
+
+		_, err = LoadStyleConfig(write("colors.yaml", "header: {fillColor: $accent}"))
+		assert.ErrorContains(t, err, "unknown color '$accent'")
+
+		_, err = LoadStyleConfig(write("broken.json", "{"))
+		assert.ErrorContains(t, err, "error parsing style config")
+	})
+}
@@ -0,0 +1,32 @@
+package xlsx_utilities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExportAsTable(t *testing.T) {
+	data := []person{{Name: "Alice", Age: 30}, {Name: "Bob", Age: 25}}
+	excelData, err := FromStruct(data)
+	assert.NoError(t, err)
+
+	t.Run("Table over exported range", func(t *testing.T) {
+		f, err := excelData.ToWorkbook(WithAnchor("B2"), WithTable("People", ""))
+		assert.NoError(t, err)
+		defer f.Close()
+
+		tables, err := f.GetTables("Sheet1")
+		assert.NoError(t, err)
+		assert.Len(t, tables, 1)
+		assert.Equal(t, "People", tables[0].Name)
+		assert.Equal(t, "B2:C4", tables[0].Range)
+		assert.Equal(t, DefaultTableStyle, tables[0].StyleName)
+	})
+
+	t.Run("Table without header row", func(t *testing.T) {
+		_, err := excelData.ToWorkbook(WithoutHeaders(), WithTable("People", "TableStyleLight1"))
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "excel table requires a header row")
+	})
+}
@@ -0,0 +1,151 @@
+package xlsx_utilities
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// tagName is the struct tag naming the column of a field, e.g. `xlsx:"Order ID"`. A tag of "-" skips the field.
+// Options follow the name after commas: omitempty writes zero values and nil pointers as blank cells,
+// order=N moves the columns of the field on export, json writes the field to one cell as JSON text,
+// map=1:Active;2:Inactive exports codes as labels and imports the labels as codes, text writes the
+// column with the Text number format and reads it without inferring numbers, keeping leading zeros,
+// min=N, max=N and oneof=a;b;c describe the valid values in import templates, and roles=hr;manager
+// exports the columns of the field only to viewers holding one of the roles.
+const tagName = "xlsx"
+
+// isColumnField reports whether the struct field is exported to and imported from a column
+func isColumnField(field reflect.StructField) bool {
+	return field.IsExported() && field.Tag.Get(tagName) != "-"
+}
+
+// fieldHeader returns the header of a struct field: the name in its xlsx tag, or the field name
+func fieldHeader(field reflect.StructField) string {
+	name, _, _ := strings.Cut(field.Tag.Get(tagName), ",")
+	if name == "" {
+		return field.Name
+	}
+	return name
+}
+
+// fieldHeaderIn returns the header of a struct field named by the tag key, e.g. xlsx_fr,
+// falling back to the xlsx tag and the field name
+func fieldHeaderIn(field reflect.StructField, key string) string {
+	if key != tagName {
+		if name, _, _ := strings.Cut(field.Tag.Get(key), ","); name != "" {
+			return name
+		}
+	}
+	return fieldHeader(field)
+}
+
+// hasTagOption reports whether the xlsx tag of the field lists the option after the name, e.g. omitempty in `xlsx:"Note,omitempty"`
+func hasTagOption(field reflect.StructField, option string) bool {
+	_, options, _ := strings.Cut(field.Tag.Get(tagName), ",")
+	for options != "" {
+		var opt string
+		opt, options, _ = strings.Cut(options, ",")
+		if opt == option {
+			return true
+		}
+	}
+	return false
+}
+
+// tagOption returns the value of a key=value option in the xlsx tag of the field, e.g. 2 for order in `xlsx:"Name,order=2"`
+func tagOption(field reflect.StructField, key string) (string, bool) {
+	_, options, _ := strings.Cut(field.Tag.Get(tagName), ",")
+	for options != "" {
+		var opt string
+		opt, options, _ = strings.Cut(options, ",")
+		if value, ok := strings.CutPrefix(opt, key+"="); ok {
+			return value, true
+		}
+	}
+	return "", false
+}
+
+// fieldOrder is the cached export order of the column fields of a struct type
+type fieldOrder struct {
+	fields []int
+	err    error
+}
+
+// columnOrders caches the export order per struct type
+var columnOrders sync.Map
+
+// columnFields returns the indexes of the column fields of struct type t in export order.
+// Fields with an order option come first, sorted by it; the others follow in declaration order.
+func columnFields(t reflect.Type) ([]int, error) {
+	if cached, ok := columnOrders.Load(t); ok {
+		order := cached.(*fieldOrder)
+		return order.fields, order.err
+	}
+
+	order := &fieldOrder{}
+	positions := make(map[int]int)
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !isColumnField(field) {
+			continue
+		}
+		order.fields = append(order.fields, i)
+		if value, ok := tagOption(field, "order"); ok {
+			position, err := strconv.Atoi(value)
+			if err != nil {
+				order.fields, order.err = nil, fmt.Errorf("invalid order %q of field %s.%s", value, t.Name(), field.Name)
+				break
+			}
+			positions[i] = position
+		}
+	}
+
+	if len(positions) > 0 {
+		sort.SliceStable(order.fields, func(a, b int) bool {
+			pa, oka := positions[order.fields[a]]
+			pb, okb := positions[order.fields[b]]
+			return oka && (!okb || pa < pb)
+		})
+	}
+
+	columnOrders.Store(t, order)
+	return order.fields, order.err
+}
+
+// fieldByHeader finds the field of struct type t named by the start of the header.
+// It returns the field and the rest of the header naming a nested field, preferring an exact
+// match and otherwise the longest field header followed by a space.
+func fieldByHeader(t reflect.Type, header string) (reflect.StructField, string, bool) {
+	best, rest := -1, ""
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !isColumnField(field) {
+			continue
+		}
+		name := fieldHeader(field)
+		if name == header {
+			return field, "", true
+		}
+		if strings.HasPrefix(header, name+" ") && (best == -1 || len(name) > len(fieldHeader(t.Field(best)))) {
+			best, rest = i, header[len(name)+1:]
+		}
+	}
+	if best != -1 {
+		return t.Field(best), rest, true
+	}
+
+	// promoted fields of embedded structs are found by name
+	name, rest, _ := strings.Cut(header, " ")
+	field, ok := t.FieldByName(name)
+	return field, rest, ok
+}
+
+// headerFieldType returns the type of the field named by the header in struct type t, with pointers dereferenced,
+// or nil when no field matches
+func headerFieldType(t reflect.Type, header string) reflect.Type {
+	return planField(t, header).target()
+}
@@ -0,0 +1,74 @@
+package xlsx_utilities
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/xuri/excelize/v2"
+)
+
+func TestExportToTemplate(t *testing.T) {
+	templatePath := "test_template.xlsx"
+	defer os.Remove(templatePath)
+
+	// Build a template with a title and a styled header cell
+	tpl := excelize.NewFile()
+	tpl.SetSheetName("Sheet1", "Report")
+	tpl.SetCellValue("Report", "A1", "Company Report")
+	style, err := tpl.NewStyle(&excelize.Style{Font: &excelize.Font{Bold: true}})
+	assert.NoError(t, err)
+	tpl.SetCellStyle("Report", "B3", "C3", style)
+	tpl.SetCellFormula("Report", "E1", "SUM(C4:C5)")
+	assert.NoError(t, tpl.SaveAs(templatePath))
+	tpl.Close()
+
+	data := []person{{Name: "Alice", Age: 30}, {Name: "Bob", Age: 25}}
+	excelData, err := FromStruct(data)
+	assert.NoError(t, err)
+
+	t.Run("Write at anchor", func(t *testing.T) {
+		f, err := excelData.ToWorkbook(WithTemplate(templatePath), WithSheet("Report"), WithAnchor("B3"))
+		assert.NoError(t, err)
+		defer f.Close()
+
+		title, _ := f.GetCellValue("Report", "A1")
+		assert.Equal(t, "Company Report", title)
+
+		header, _ := f.GetCellValue("Report", "B3")
+		assert.Equal(t, "Name", header)
+		value, _ := f.GetCellValue("Report", "C5")
+		assert.Equal(t, "25", value)
+
+		headerStyle, _ := f.GetCellStyle("Report", "B3")
+		assert.Equal(t, style, headerStyle)
+
+		formula, _ := f.GetCellFormula("Report", "E1")
+		assert.Equal(t, "SUM(C4:C5)", formula)
+	})
+
+	t.Run("Without headers", func(t *testing.T) {
+		f, err := excelData.ToWorkbook(WithTemplate(templatePath), WithSheet("Report"), WithAnchor("B4"), WithoutHeaders())
+		assert.NoError(t, err)
+		defer f.Close()
+
+		header, _ := f.GetCellValue("Report", "B3")
+		assert.Equal(t, "", header)
+		value, _ := f.GetCellValue("Report", "B4")
+		assert.Equal(t, "Alice", value)
+	})
+
+	t.Run("Missing sheet", func(t *testing.T) {
+		_, err := excelData.ToWorkbook(WithTemplate(templatePath), WithSheet("Data"))
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "sheet 'Data' not found in template")
+	})
+
+	t.Run("Invalid anchor", func(t *testing.T) {
+		_, err := excelData.ToWorkbook(WithAnchor("not a cell"))
+		assert.Error(t, err)
+
+		// ToFile has no error to return and returns nil
+		assert.Nil(t, excelData.Clone().WithOptions(WithAnchor("not a cell")).ToFile())
+	})
+}
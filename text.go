@@ -0,0 +1,143 @@
+package xlsx_utilities
+
+import (
+	"strings"
+
+	"github.com/xuri/excelize/v2"
+	"golang.org/x/text/unicode/norm"
+)
+
+// WithTextNormalization normalizes multi-line text in cells. Line breaks written as CRLF, CR,
+// vertical tab or form feed become "\n" and other control characters except tab are removed,
+// on import and on export. Exported cells containing a line break are styled to wrap text.
+func WithTextNormalization() Option {
+	return func(c *config) {
+		c.normalizeText = true
+	}
+}
+
+// WithTrimSpace removes leading and trailing white space, including non-breaking spaces,
+// from every cell on import, headers included, before values are converted
+func WithTrimSpace() Option {
+	return func(c *config) {
+		c.trimSpace = true
+	}
+}
+
+// WithCollapseWhitespace replaces every run of white space, line breaks included, with a single space
+// in every cell on import, headers included, before values are converted
+func WithCollapseWhitespace() Option {
+	return func(c *config) {
+		c.collapseWhitespace = true
+	}
+}
+
+// WithNormalizeUnicode applies Unicode NFKC normalization to every cell on import, headers included,
+// so compatibility characters such as non-breaking spaces and full-width digits become their plain forms
+func WithNormalizeUnicode() Option {
+	return func(c *config) {
+		c.normalizeUnicode = true
+	}
+}
+
+// cleanCell applies the configured Unicode normalization, white space collapsing and trimming
+func cleanCell(s string, cfg *config) string {
+	if cfg.normalizeUnicode {
+		s = norm.NFKC.String(s)
+	}
+	if cfg.collapseWhitespace {
+		s = strings.Join(strings.Fields(s), " ")
+	}
+	if cfg.trimSpace {
+		s = strings.TrimSpace(s)
+	}
+	return s
+}
+
+// cleanRows applies cleanCell to every cell in place
+func cleanRows(rows [][]string, cfg *config) {
+	for _, row := range rows {
+		for i, cell := range row {
+			row[i] = cleanCell(cell, cfg)
+		}
+	}
+}
+
+// exportText applies the configured text normalization and formula escaping to an exported value
+func exportText(value interface{}, cfg *config) interface{} {
+	s, ok := value.(string)
+	if !ok {
+		return value
+	}
+	if cfg.normalizeText {
+		s = normalizeText(s)
+	}
+	if cfg.formulaPrefix != "" {
+		s = escapeFormula(s, cfg.formulaPrefix)
+	}
+	return s
+}
+
+// normalizeText converts line breaks to "\n" and drops control characters other than tab and newline
+func normalizeText(s string) string {
+	if !strings.ContainsFunc(s, isControl) {
+		return s
+	}
+
+	s = strings.ReplaceAll(s, "\r\n", "\n")
+
+	var b strings.Builder
+	b.Grow(len(s))
+	for _, r := range s {
+		switch {
+		case r == '\r' || r == '\v' || r == '\f':
+			b.WriteRune('\n')
+		case r == '\t' || r == '\n' || !isControl(r):
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// isControl reports whether r is a C0 or C1 control character
+func isControl(r rune) bool {
+	return r < 0x20 || r >= 0x7f && r < 0xa0
+}
+
+// normalizeRows normalizes the text of every cell in place
+func normalizeRows(rows [][]string) {
+	for _, row := range rows {
+		for i, cell := range row {
+			row[i] = normalizeText(cell)
+		}
+	}
+}
+
+// applyWrapText turns on text wrapping for data cells containing a line break,
+// keeping the rest of the cell style
+func applyWrapText(f *excelize.File, layout sheetLayout, cfg *config, rows [][]interface{}) error {
+	if !cfg.normalizeText {
+		return nil
+	}
+
+	wrap := newRestyler(f, layout.Sheet, func(style *excelize.Style) {
+		if style.Alignment == nil {
+			style.Alignment = &excelize.Alignment{}
+		}
+		style.Alignment.WrapText = true
+	})
+
+	for rowIndex, row := range rows {
+		for i, value := range row {
+			s, ok := value.(string)
+			if !ok || !strings.Contains(normalizeText(s), "\n") {
+				continue
+			}
+			if err := wrap.restyle(layout.cell(i, layout.firstDataRow()+rowIndex)); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
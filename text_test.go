@@ -0,0 +1,100 @@
+package xlsx_utilities
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/xuri/excelize/v2"
+)
+
+func TestNormalizeText(t *testing.T) {
+	assert.Equal(t, "a\nb\nc\nd\te", normalizeText("a\r\nb\rc\vd\te\x00"))
+	assert.Equal(t, "plain", normalizeText("plain"))
+}
+
+func TestTextNormalization(t *testing.T) {
+	type note struct {
+		Title string
+		Body  string
+	}
+
+	t.Run("Export wraps multi-line cells", func(t *testing.T) {
+		excelData, err := FromStruct([]note{{Title: "One", Body: "line 1\r\nline 2"}})
+		assert.NoError(t, err)
+
+		f, err := excelData.ToWorkbook(WithTextNormalization(), WithStyles(StyleSheet{
+			Columns: map[string]*Style{"Body": {Bold: true}},
+		}))
+		assert.NoError(t, err)
+		defer f.Close()
+
+		value, _ := f.GetCellValue("Sheet1", "B2")
+		assert.Equal(t, "line 1\nline 2", value)
+
+		id, err := f.GetCellStyle("Sheet1", "B2")
+		assert.NoError(t, err)
+		style, err := f.GetStyle(id)
+		assert.NoError(t, err)
+		assert.True(t, style.Alignment.WrapText)
+		assert.True(t, style.Font.Bold)
+
+		id, err = f.GetCellStyle("Sheet1", "A2")
+		assert.NoError(t, err)
+		assert.Equal(t, 0, id)
+	})
+
+	t.Run("Import normalizes line breaks", func(t *testing.T) {
+		filename := "test_text.xlsx"
+		defer os.Remove(filename)
+
+		f := excelize.NewFile()
+		f.SetCellValue("Sheet1", "A1", "Title")
+		f.SetCellValue("Sheet1", "B1", "Body")
+		f.SetCellValue("Sheet1", "A2", "One")
+		f.SetCellValue("Sheet1", "B2", "line 1\r\nline 2\rline 3")
+		assert.NoError(t, f.SaveAs(filename))
+		f.Close()
+
+		excelData, err := FromExcel[note](filename, WithTextNormalization())
+		assert.NoError(t, err)
+
+		result := excelData.ToStruct()
+		assert.Empty(t, result.Errors)
+		assert.Equal(t, "line 1\nline 2\nline 3", result.Data[0].Body)
+	})
+}
+
+func TestCellCleaning(t *testing.T) {
+	filename := "test_cleaning.xlsx"
+	defer os.Remove(filename)
+
+	f := excelize.NewFile()
+	f.SetSheetRow("Sheet1", "A1", &[]interface{}{" Name ", "Age "})
+	f.SetSheetRow("Sheet1", "A2", &[]interface{}{"  Mary \t Ann  ", " ４２ "})
+	assert.NoError(t, f.SaveAs(filename))
+	f.Close()
+
+	t.Run("Raw cells", func(t *testing.T) {
+		excelData, err := FromExcel[person](filename)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{" Name ", "Age "}, excelData.Headers)
+	})
+
+	t.Run("All cleanups", func(t *testing.T) {
+		excelData, err := FromExcel[person](filename, WithNormalizeUnicode(), WithCollapseWhitespace(), WithTrimSpace())
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"Name", "Age"}, excelData.Headers)
+
+		result := excelData.ToStruct()
+		assert.Empty(t, result.Errors)
+		assert.Empty(t, result.CellWarnings)
+		assert.Equal(t, []person{{Name: "Mary Ann", Age: 42}}, result.Data)
+	})
+
+	t.Run("Trim only", func(t *testing.T) {
+		excelData, err := FromExcel[person](filename, WithTrimSpace())
+		assert.NoError(t, err)
+		assert.Equal(t, []interface{}{"Mary \t Ann", "４２"}, excelData.Rows[0])
+	})
+}
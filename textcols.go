@@ -0,0 +1,64 @@
+package xlsx_utilities
+
+import (
+	"reflect"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// textNumFmt is the built-in Text number format, shown as "@" in Excel
+const textNumFmt = 49
+
+// textColumns returns the indexes of the headers naming fields tagged with the text option,
+// e.g. `xlsx:"Phone,text"`, or nil when there are none
+func textColumns(t reflect.Type, headers []string) map[int]bool {
+	if t.Kind() != reflect.Struct {
+		return nil
+	}
+
+	var text map[int]bool
+	for i, header := range headers {
+		plan := planField(t, header)
+		if plan.err != nil || !hasTagOption(plan.leaf, "text") {
+			continue
+		}
+		if text == nil {
+			text = make(map[int]bool)
+		}
+		text[i] = true
+	}
+	return text
+}
+
+// textValue returns the value of a text column as the text written to its cell
+func textValue(value interface{}) interface{} {
+	if value == nil {
+		return nil
+	}
+	return cellText(value)
+}
+
+// applyTextFormats sets the Text number format on the data cells of text columns,
+// so values typed into them later are not converted by Excel either
+func applyTextFormats(f *excelize.File, layout sheetLayout, t reflect.Type, headers []string) error {
+	text := textColumns(t, headers)
+	if len(text) == 0 {
+		return nil
+	}
+
+	r := newRestyler(f, layout.Sheet, func(style *excelize.Style) {
+		style.NumFmt = textNumFmt
+		style.CustomNumFmt = nil
+	})
+	for i := range headers {
+		if !text[i] {
+			continue
+		}
+		for rowIndex := 0; rowIndex < layout.Rows; rowIndex++ {
+			if err := r.restyle(layout.cell(i, layout.firstDataRow()+rowIndex)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
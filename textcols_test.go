@@ -0,0 +1,57 @@
+package xlsx_utilities
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/xuri/excelize/v2"
+)
+
+func TestTextColumns(t *testing.T) {
+	type contact struct {
+		Name    string
+		Phone   string `xlsx:"Phone,text"`
+		Account int64  `xlsx:"Account,text"`
+		Zip     string
+	}
+	data := []contact{
+		{"Alice", "0812345678", 12345678901234567, "01234"},
+		{"Bob", "+62 21 555", 42, "90210"},
+	}
+	filename := "test_text_columns.xlsx"
+	defer os.Remove(filename)
+
+	excelData, err := FromStruct(data)
+	assert.NoError(t, err)
+	assert.NoError(t, excelData.Save(filename))
+
+	f, err := excelize.OpenFile(filename)
+	assert.NoError(t, err)
+	for _, cell := range []string{"B2", "C2", "C3"} {
+		styleID, err := f.GetCellStyle("Sheet1", cell)
+		assert.NoError(t, err)
+		style, err := f.GetStyle(styleID)
+		assert.NoError(t, err)
+		assert.Equal(t, textNumFmt, style.NumFmt, cell)
+
+		cellType, err := f.GetCellType("Sheet1", cell)
+		assert.NoError(t, err)
+		assert.Equal(t, excelize.CellTypeSharedString, cellType, cell)
+	}
+	value, err := f.GetCellValue("Sheet1", "C2")
+	assert.NoError(t, err)
+	assert.Equal(t, "12345678901234567", value)
+	f.Close()
+
+	imported, err := FromExcel[contact](filename)
+	assert.NoError(t, err)
+	assert.Equal(t, "0812345678", imported.Rows[0][1])
+	assert.Equal(t, 1234, imported.Rows[0][3])
+
+	result := imported.ToStruct()
+	assert.Empty(t, result.Errors)
+	assert.Equal(t, data[0].Phone, result.Data[0].Phone)
+	assert.Equal(t, data[0].Account, result.Data[0].Account)
+	assert.Equal(t, "1234", result.Data[0].Zip)
+}
@@ -0,0 +1,98 @@
+package xlsx_utilities
+
+import (
+	"fmt"
+	"sort"
+)
+
+// themes are the built-in style presets selectable with WithTheme
+var themes = map[string]StyleSheet{
+	"plain": {
+		Header: &Style{Bold: true},
+	},
+	"report": {
+		Workbook: &Style{FontFamily: "Calibri", FontSize: 11, BorderColor: "BFBFBF"},
+		Header:   &Style{Bold: true, FontColor: "FFFFFF", FillColor: "1F4E78", HAlign: "center"},
+	},
+	"striped": {
+		Header: &Style{Bold: true, FillColor: "D9E1F2"},
+		Row: func(rowIndex int, row []interface{}) *Style {
+			if rowIndex%2 == 1 {
+				return &Style{FillColor: "F2F2F2"}
+			}
+			return nil
+		},
+	},
+	"dark header": {
+		Header: &Style{Bold: true, FontColor: "FFFFFF", FillColor: "262626"},
+	},
+}
+
+// WithTheme styles the export with a built-in preset: "plain" (bold headers), "report" (bordered
+// cells under a blue header), "striped" (alternating row fills) or "dark header".
+// A style sheet given with WithStyles applies on top of the theme.
+func WithTheme(name string) Option {
+	return func(c *config) {
+		c.theme = name
+	}
+}
+
+// Themes returns the names of the built-in style presets
+func Themes() []string {
+	names := make([]string, 0, len(themes))
+	for name := range themes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// styleSheet returns the configured style sheet on top of the theme, or nil when neither is set
+func (c *config) styleSheet() (*StyleSheet, error) {
+	if c.theme == "" {
+		return c.styles, nil
+	}
+
+	theme, ok := themes[c.theme]
+	if !ok {
+		return nil, fmt.Errorf("unknown theme '%s'", c.theme)
+	}
+	if c.styles == nil {
+		return &theme, nil
+	}
+
+	ss := *c.styles
+	ss.Workbook = overlay(theme.Workbook, ss.Workbook)
+	ss.Sheet = overlay(theme.Sheet, ss.Sheet)
+	ss.Header = overlay(theme.Header, ss.Header)
+
+	columns := make(map[string]*Style)
+	for header, style := range theme.Columns {
+		columns[header] = style
+	}
+	for header, style := range c.styles.Columns {
+		columns[header] = overlay(columns[header], style)
+	}
+	ss.Columns = columns
+
+	if themeRow, row := theme.Row, c.styles.Row; themeRow != nil && row != nil {
+		ss.Row = func(rowIndex int, values []interface{}) *Style {
+			return overlay(themeRow(rowIndex, values), row(rowIndex, values))
+		}
+	} else if row == nil {
+		ss.Row = themeRow
+	}
+	return &ss, nil
+}
+
+// overlay returns the style with the set fields of top applied on top of base, or nil when both are nil
+func overlay(base, top *Style) *Style {
+	if base == nil {
+		return top
+	}
+	if top == nil {
+		return base
+	}
+	merged := base.merge(top)
+	return &merged
+}
@@ -0,0 +1,56 @@
+package xlsx_utilities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/xuri/excelize/v2"
+)
+
+func TestThemes(t *testing.T) {
+	data := []person{{Name: "Alice", Age: 30}, {Name: "Bob", Age: 17}, {Name: "Charlie", Age: 35}}
+	excelData, err := FromStruct(data)
+	assert.NoError(t, err)
+
+	assert.Equal(t, []string{"dark header", "plain", "report", "striped"}, Themes())
+
+	t.Run("Every theme exports", func(t *testing.T) {
+		for _, name := range Themes() {
+			f, err := excelData.ToWorkbook(WithTheme(name))
+			assert.NoError(t, err, name)
+			f.Close()
+		}
+	})
+
+	t.Run("Striped rows under the style sheet", func(t *testing.T) {
+		f, err := excelData.ToWorkbook(WithTheme("striped"), WithStyles(StyleSheet{
+			Header:  &Style{FontColor: "FF0000"},
+			Columns: map[string]*Style{"Age": {HAlign: "right"}},
+		}))
+		assert.NoError(t, err)
+		defer f.Close()
+
+		style := func(cell string) *excelize.Style {
+			id, err := f.GetCellStyle("Sheet1", cell)
+			assert.NoError(t, err)
+			s, err := f.GetStyle(id)
+			assert.NoError(t, err)
+			return s
+		}
+
+		header := style("A1")
+		assert.True(t, header.Font.Bold)
+		assert.Equal(t, "FF0000", header.Font.Color)
+		assert.Equal(t, []string{"D9E1F2"}, header.Fill.Color)
+
+		assert.Empty(t, style("A2").Fill.Color)
+		assert.Equal(t, []string{"F2F2F2"}, style("A3").Fill.Color)
+		assert.Equal(t, "right", style("B3").Alignment.Horizontal)
+		assert.Empty(t, style("A4").Fill.Color)
+	})
+
+	t.Run("Unknown theme", func(t *testing.T) {
+		_, err := excelData.ToWorkbook(WithTheme("neon"))
+		assert.ErrorContains(t, err, "unknown theme 'neon'")
+	})
+}
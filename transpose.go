@@ -0,0 +1,68 @@
+package xlsx_utilities
+
+import (
+	"fmt"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// WithTransposed lays the data out vertically: headers run down the first column from the anchor
+// and each record fills the next column, as in single-record summaries and comparison sheets.
+// FromExcel reads sheets written this way back into rows. Tables, pivot tables and charts need the
+// regular layout and are rejected.
+func WithTransposed() Option {
+	return func(c *config) {
+		c.transposed = true
+	}
+}
+
+// checkTransposed verifies that a transposed export fits the sheet and uses no row-oriented features
+func checkTransposed(cfg *config, headers, rows int) error {
+	if cfg.table != nil || len(cfg.pivots) > 0 || len(cfg.charts) > 0 {
+		return fmt.Errorf("tables, pivot tables and charts are not supported in a transposed export")
+	}
+
+	col, row, err := excelize.CellNameToCoordinates(cfg.anchor)
+	if err != nil {
+		return fmt.Errorf("invalid anchor cell '%s': %w", cfg.anchor, err)
+	}
+	cols := rows
+	if !cfg.skipHeaders {
+		cols++
+	}
+	if headers > excelize.TotalRows-row+1 || cols > excelize.MaxColumns-col+1 {
+		return fmt.Errorf("transposed export of %d columns and %d records does not fit the sheet", headers, rows)
+	}
+	return nil
+}
+
+// transposeRows swaps the rows and columns of the cell text read from a sheet
+func transposeRows(rows [][]string) [][]string {
+	width := 0
+	for _, row := range rows {
+		width = max(width, len(row))
+	}
+
+	transposed := make([][]string, width)
+	for c := range transposed {
+		transposed[c] = make([]string, len(rows))
+		for r, row := range rows {
+			if c < len(row) {
+				transposed[c][r] = row[c]
+			}
+		}
+	}
+	return trimRows(transposed)
+}
+
+// trimRows drops the trailing empty cells of each row, as GetRows does
+func trimRows(rows [][]string) [][]string {
+	for r, row := range rows {
+		end := len(row)
+		for end > 0 && row[end-1] == "" {
+			end--
+		}
+		rows[r] = row[:end]
+	}
+	return rows
+}
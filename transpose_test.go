@@ -0,0 +1,55 @@
+package xlsx_utilities
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTransposed(t *testing.T) {
+	filename := "test_transposed.xlsx"
+	defer os.Remove(filename)
+
+	excelData, err := FromStruct([]person{{Name: "Alice", Age: 30}, {Name: "Bob", Age: 25}})
+	assert.NoError(t, err)
+	assert.NoError(t, excelData.SetProvenance(1, "Age", ProvenanceDefaulted))
+
+	t.Run("Headers down the first column", func(t *testing.T) {
+		f, err := excelData.ToWorkbook(WithTransposed(), WithAnchor("B2"), WithStyles(StyleSheet{Header: &Style{Bold: true}}))
+		assert.NoError(t, err)
+		defer f.Close()
+
+		rows, err := f.GetRows("Sheet1")
+		assert.NoError(t, err)
+		assert.Equal(t, [][]string{nil, {"", "Name", "Alice", "Bob"}, {"", "Age", "30", "25"}}, rows)
+
+		id, err := f.GetCellStyle("Sheet1", "B3")
+		assert.NoError(t, err)
+		style, err := f.GetStyle(id)
+		assert.NoError(t, err)
+		assert.True(t, style.Font.Bold)
+	})
+
+	t.Run("Round trip", func(t *testing.T) {
+		assert.NoError(t, excelData.Save(filename, WithTransposed(), WithProvenance()))
+
+		imported, err := FromExcel[person](filename, WithTransposed(), WithProvenance())
+		assert.NoError(t, err)
+		assert.Equal(t, excelData.Headers, imported.Headers)
+		assert.Equal(t, []person{{Name: "Alice", Age: 30}, {Name: "Bob", Age: 25}}, imported.ToStruct().Data)
+		assert.Equal(t, ProvenanceDefaulted, imported.ProvenanceOf(1, "Age"))
+	})
+
+	t.Run("Row-oriented features", func(t *testing.T) {
+		_, err := excelData.ToWorkbook(WithTransposed(), WithTable("People", ""))
+		assert.EqualError(t, err, "tables, pivot tables and charts are not supported in a transposed export")
+
+		_, err = excelData.ToWorkbook(WithTransposed(), WithAnchor("XFD1"))
+		assert.EqualError(t, err, "transposed export of 2 columns and 2 records does not fit the sheet")
+	})
+
+	t.Run("Transpose rows", func(t *testing.T) {
+		assert.Equal(t, [][]string{{"a", "c"}, {"b"}}, transposeRows([][]string{{"a", "b"}, {"c"}}))
+	})
+}
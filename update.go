@@ -0,0 +1,166 @@
+package xlsx_utilities
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// keyedSheet locates the rows of an existing sheet by the text of a key column
+type keyedSheet struct {
+	sheet   string
+	columns []int          // 1-based sheet column of each exported header, 0 when the sheet lacks it
+	rows    map[string]int // 1-based sheet row of each key
+	lastRow int            // 1-based row of the last row with content
+}
+
+// readKeyedSheet reads the header row and the key column of the sheet, matching the headers to the sheet columns
+func readKeyedSheet(f *excelize.File, sheet, keyHeader string, headers []string) (*keyedSheet, error) {
+	rows, err := f.GetRows(sheet)
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, ErrEmptyFile
+	}
+
+	ks := &keyedSheet{sheet: sheet, columns: make([]int, len(headers)), rows: make(map[string]int), lastRow: len(rows)}
+	for i, header := range headers {
+		ks.columns[i] = indexOf(rows[0], header) + 1
+	}
+
+	keyCol := indexOf(rows[0], keyHeader)
+	if keyCol == -1 {
+		return nil, fmt.Errorf("%w: key column '%s' not found in sheet '%s'", ErrHeaderMismatch, keyHeader, sheet)
+	}
+	for i, row := range rows[1:] {
+		if keyCol >= len(row) || row[keyCol] == "" {
+			continue
+		}
+		if _, ok := ks.rows[row[keyCol]]; !ok {
+			ks.rows[row[keyCol]] = i + 2
+		}
+	}
+	return ks, nil
+}
+
+// writeRow sets the cells of the exported values on the 1-based sheet row, leaving the other columns untouched
+func (ks *keyedSheet) writeRow(f *excelize.File, row int, values []interface{}, cfg *config) error {
+	for i, value := range values {
+		if ks.columns[i] == 0 {
+			continue
+		}
+		value = exportText(value, cfg)
+		cell, err := excelize.CoordinatesToCellName(ks.columns[i], row)
+		if err != nil {
+			return err
+		}
+		if err := f.SetCellValue(ks.sheet, cell, localizeValue(value, cfg)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WithUpsert makes UpdateExcel append the updates whose key is not found on the sheet below its last row,
+// instead of failing, so periodic sync jobs can maintain a living spreadsheet
+func WithUpsert() Option {
+	return func(c *config) {
+		c.upsert = true
+	}
+}
+
+// UpdateExcel rewrites the rows of an existing workbook whose key column matches one of the updates.
+// Only the cells of the exported columns found on the sheet are set; other sheets, columns, styles and
+// formulas are kept. It fails without saving when the key of an update is not found on the sheet,
+// unless WithUpsert is given.
+func UpdateExcel[T comparable](filename, sheet, keyHeader string, updates []T, opts ...Option) error {
+	ed, err := FromStruct(updates, opts...)
+	if err != nil {
+		return err
+	}
+	cfg := ed.config()
+
+	keyCol := indexOf(ed.Headers, keyHeader)
+	if keyCol == -1 {
+		return fmt.Errorf("%w: unknown key column '%s'", ErrHeaderMismatch, keyHeader)
+	}
+
+	f, err := excelize.OpenFile(filename)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	ks, err := readKeyedSheet(f, sheet, keyHeader, ed.Headers)
+	if err != nil {
+		return err
+	}
+
+	var missing []string
+	for _, values := range ed.Rows {
+		key := cellText(cellAt(values, keyCol))
+		row, ok := ks.rows[key]
+		if !ok && cfg.upsert {
+			ks.lastRow++
+			row, ks.rows[key] = ks.lastRow, ks.lastRow
+		} else if !ok {
+			missing = append(missing, fmt.Sprintf("'%s'", key))
+			continue
+		}
+		if err := ks.writeRow(f, row, values, cfg); err != nil {
+			return err
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("keys not found in sheet '%s': %s", sheet, strings.Join(missing, ", "))
+	}
+
+	return saveWorkbook(f, filename, cfg)
+}
+
+// DeleteRows removes the data rows of an existing workbook for which remove returns true and returns how many were removed.
+// Rows below are shifted up; other sheets, styles and formulas are kept.
+func DeleteRows(filename, sheet string, remove func(row Row) bool) (int, error) {
+	f, err := excelize.OpenFile(filename)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+
+	rows, err := f.GetRows(sheet)
+	if err != nil {
+		return 0, err
+	}
+	if len(rows) == 0 {
+		return 0, ErrEmptyFile
+	}
+
+	ed := NewExcelData[struct{}](rows[0])
+	for _, row := range rows[1:] {
+		values := make([]interface{}, len(row))
+		for i, cell := range row {
+			values[i] = convertCellValue(cell)
+		}
+		ed.Rows = append(ed.Rows, values)
+	}
+
+	// remove from the bottom up, so the rows still to remove keep their position
+	rowAt := ed.newRows()
+	removed := 0
+	for i := len(ed.Rows) - 1; i >= 0; i-- {
+		if !remove(rowAt(i)) {
+			continue
+		}
+		if err := f.RemoveRow(sheet, i+2); err != nil {
+			return 0, err
+		}
+		removed++
+	}
+	if removed == 0 {
+		return 0, nil
+	}
+
+	return removed, saveWorkbook(f, filename, newConfig(nil))
+}
@@ -0,0 +1,90 @@
+package xlsx_utilities
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/xuri/excelize/v2"
+)
+
+func TestUpdateExcel(t *testing.T) {
+	filename := "test_update.xlsx"
+	defer os.Remove(filename)
+
+	excelData, err := FromStruct([]person{{Name: "Alice", Age: 30}, {Name: "Bob", Age: 25}})
+	assert.NoError(t, err)
+	f, err := excelData.ToWorkbook(WithSheet("Staff"))
+	assert.NoError(t, err)
+	assert.NoError(t, f.SetCellValue("Staff", "C1", "Next Year"))
+	assert.NoError(t, f.SetCellFormula("Staff", "C3", "B3+1"))
+	_, err = f.NewSheet("Summary")
+	assert.NoError(t, err)
+	assert.NoError(t, f.SetCellValue("Summary", "A1", "kept"))
+	assert.NoError(t, f.SaveAs(filename))
+	f.Close()
+
+	assert.NoError(t, UpdateExcel(filename, "Staff", "Name", []person{{Name: "Bob", Age: 26}}))
+
+	f, err = excelize.OpenFile(filename)
+	assert.NoError(t, err)
+	rows, err := f.GetRows("Staff")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Alice", "30"}, rows[1])
+	assert.Equal(t, []string{"Bob", "26"}, rows[2][:2])
+	formula, err := f.GetCellFormula("Staff", "C3")
+	assert.NoError(t, err)
+	assert.Equal(t, "B3+1", formula)
+	value, err := f.GetCellValue("Summary", "A1")
+	assert.NoError(t, err)
+	assert.Equal(t, "kept", value)
+	f.Close()
+
+	t.Run("Unknown key", func(t *testing.T) {
+		err := UpdateExcel(filename, "Staff", "Name", []person{{Name: "Alice", Age: 31}, {Name: "Carol", Age: 41}})
+		assert.ErrorContains(t, err, "keys not found in sheet 'Staff': 'Carol'")
+
+		imported, err := FromExcel[person](filename, WithSheet("Staff"))
+		assert.NoError(t, err)
+		assert.Equal(t, "30", cellText(imported.Rows[0][1]))
+	})
+
+	t.Run("Unknown key column", func(t *testing.T) {
+		err := UpdateExcel(filename, "Staff", "Salary", []person{{Name: "Alice"}})
+		assert.ErrorIs(t, err, ErrHeaderMismatch)
+	})
+
+	t.Run("Upsert", func(t *testing.T) {
+		err := UpdateExcel(filename, "Staff", "Name", []person{{Name: "Carol", Age: 41}, {Name: "Alice", Age: 31}}, WithUpsert())
+		assert.NoError(t, err)
+
+		imported, err := FromExcel[person](filename, WithSheet("Staff"))
+		assert.NoError(t, err)
+		assert.Len(t, imported.Rows, 3)
+		assert.Equal(t, "Carol", cellText(imported.Rows[2][0]))
+		assert.Equal(t, "41", cellText(imported.Rows[2][1]))
+		assert.Equal(t, "31", cellText(imported.Rows[0][1]))
+	})
+}
+
+func TestDeleteRows(t *testing.T) {
+	filename := "test_delete_rows.xlsx"
+	defer os.Remove(filename)
+
+	excelData, err := FromStruct([]person{{Name: "Alice", Age: 30}, {Name: "Bob", Age: 17}, {Name: "Carol", Age: 15}, {Name: "Dave", Age: 41}})
+	assert.NoError(t, err)
+	assert.NoError(t, excelData.Save(filename))
+
+	removed, err := DeleteRows(filename, "Sheet1", func(row Row) bool {
+		age, err := row.Float("Age")
+		return err == nil && age < 18
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, removed)
+
+	imported, err := FromExcel[person](filename)
+	assert.NoError(t, err)
+	assert.Len(t, imported.Rows, 2)
+	assert.Equal(t, "Alice", imported.Rows[0][0])
+	assert.Equal(t, "Dave", imported.Rows[1][0])
+}
@@ -1,6 +1,10 @@
 package xlsx_utilities
 
-import "strconv"
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
 
 // convertCellValue attempts to convert string cell values to appropriate types
 func convertCellValue(value string) interface{} {
@@ -22,3 +26,38 @@ func convertCellValue(value string) interface{} {
 	// If all else fails, return as string
 	return value
 }
+
+// rowKey joins the text of the key columns of the row
+func rowKey(row []interface{}, keyCols []int) string {
+	parts := make([]string, len(keyCols))
+	for i, col := range keyCols {
+		parts[i] = cellText(cellAt(row, col))
+	}
+	return strings.Join(parts, "\x00")
+}
+
+// cellAt returns the value at the column, or nil for short rows
+func cellAt(row []interface{}, col int) interface{} {
+	if col < len(row) {
+		return row[col]
+	}
+	return nil
+}
+
+// cellText returns the text of a cell value, with nil as an empty cell
+func cellText(value interface{}) string {
+	if value == nil {
+		return ""
+	}
+	return fmt.Sprint(value)
+}
+
+// indexOf returns the index of the header, or -1
+func indexOf(headers []string, header string) int {
+	for i, h := range headers {
+		if h == header {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,50 @@
+package xlsx_utilities
+
+import "bytes"
+
+// ValidationReport is the outcome of a dry-run import: the problems ToStruct would report,
+// without the records themselves
+type ValidationReport struct {
+	Rows           int // number of data rows checked
+	Errors         []ImportError
+	HeaderWarnings []string
+	CellWarnings   []ImportWarning
+}
+
+// Valid reports whether every row would be imported without errors
+func (r ValidationReport) Valid() bool {
+	return len(r.Errors) == 0
+}
+
+// Validate reads an Excel file as FromExcel and ToStruct would, running the header checks, type
+// conversions and WithAfterReadRow hooks, but only returns the error report. The error is set when
+// the file cannot be read at all, such as an empty file or an exceeded upload limit.
+func Validate[T comparable](filename string, opts ...Option) (ValidationReport, error) {
+	ed, err := FromExcel[T](filename, opts...)
+	if err != nil {
+		return ValidationReport{}, err
+	}
+	return ed.Validate(), nil
+}
+
+// ValidateReader is Validate for an uploaded file held in memory
+func ValidateReader[T comparable](file *bytes.Reader, opts ...Option) (ValidationReport, error) {
+	ed, err := FromFileExcel[T](file, opts...)
+	if err != nil {
+		return ValidationReport{}, err
+	}
+	return ed.Validate(), nil
+}
+
+// Validate converts every row like ToStruct, discarding each record once it is checked
+func (ed *ExcelData[T]) Validate() ValidationReport {
+	conv := ed.newRowConverter(ed.config())
+
+	report := ValidationReport{Rows: len(ed.Rows), HeaderWarnings: conv.headerWarnings}
+	conv.convertRows(ed.Rows, func(row convertedRow[T]) bool {
+		report.Errors = append(report.Errors, row.errors...)
+		report.CellWarnings = append(report.CellWarnings, row.warnings...)
+		return true
+	})
+	return report
+}
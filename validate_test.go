@@ -0,0 +1,73 @@
+package xlsx_utilities
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/xuri/excelize/v2"
+)
+
+func TestValidate(t *testing.T) {
+	type shipment struct {
+		Name string
+		Due  time.Time
+	}
+	filename := "test_validate.xlsx"
+	defer os.Remove(filename)
+
+	f := excelize.NewFile()
+	assert.NoError(t, f.SetSheetRow("Sheet1", "A1", &[]interface{}{"Name", "Due", "Notes"}))
+	assert.NoError(t, f.SetSheetRow("Sheet1", "A2", &[]interface{}{"Alice", "2024-05-01", "fragile"}))
+	assert.NoError(t, f.SetSheetRow("Sheet1", "A3", &[]interface{}{"Bob", "soon"}))
+	assert.NoError(t, f.SetSheetRow("Sheet1", "A4", &[]interface{}{"", "2024-06-01"}))
+	assert.NoError(t, f.SaveAs(filename))
+	f.Close()
+
+	requireName := WithAfterReadRow(func(rowIndex int, item *shipment) error {
+		if item.Name == "" {
+			return errors.New("name is required")
+		}
+		return nil
+	})
+
+	report, err := Validate[shipment](filename, WithHeaderEvolution(), requireName)
+	assert.NoError(t, err)
+	assert.False(t, report.Valid())
+	assert.Equal(t, 3, report.Rows)
+	assert.Equal(t, []string{"column 'Notes' has no matching field and is ignored"}, report.HeaderWarnings)
+	if assert.Len(t, report.Errors, 2) {
+		assert.Equal(t, "B3", report.Errors[0].Cell)
+		assert.Equal(t, 4, report.Errors[1].RowIndex)
+		assert.EqualError(t, report.Errors[1].Err, "name is required")
+	}
+
+	t.Run("MatchesImport", func(t *testing.T) {
+		excelData, err := FromExcel[shipment](filename, WithHeaderEvolution(), requireName)
+		assert.NoError(t, err)
+		assert.Equal(t, excelData.ToStruct().Errors, report.Errors)
+	})
+
+	t.Run("Reader", func(t *testing.T) {
+		content, err := os.ReadFile(filename)
+		assert.NoError(t, err)
+
+		report, err := ValidateReader[shipment](bytes.NewReader(content), WithHeaderEvolution())
+		assert.NoError(t, err)
+		assert.Len(t, report.Errors, 1)
+	})
+
+	t.Run("EmptyFile", func(t *testing.T) {
+		empty := "test_validate_empty.xlsx"
+		defer os.Remove(empty)
+		f := excelize.NewFile()
+		assert.NoError(t, f.SaveAs(empty))
+		f.Close()
+
+		_, err := Validate[shipment](empty)
+		assert.ErrorIs(t, err, ErrEmptyFile)
+	})
+}
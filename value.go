@@ -7,6 +7,9 @@ import (
 )
 
 func getStructValues(v reflect.Value) ([]interface{}, error) {
+	if m, ok := v.Interface().(RowMarshaler); ok {
+		return m.MarshalXLSXRow()
+	}
 	return getNestedValues(v)
 }
 
@@ -18,10 +21,10 @@ func getNestedValues(v reflect.Value) ([]interface{}, error) {
 		v = v.Elem()
 	}
 
-	if converter, ok := TypeConverters[v.Type()]; ok {
+	if converter, ok := converterFor(v.Type()); ok {
 		converted, err := converter(v.Interface())
 		if err != nil {
-			return nil, fmt.Errorf("error converting custom type: %v", err)
+			return nil, fmt.Errorf("error converting custom type: %w", err)
 		}
 		return []interface{}{converted}, nil
 	}
@@ -33,20 +36,48 @@ func getNestedValues(v reflect.Value) ([]interface{}, error) {
 		return []interface{}{v.Interface()}, nil
 	}
 
+	fields, err := columnFields(v.Type())
+	if err != nil {
+		return nil, err
+	}
+
 	var values []interface{}
 
-	for i := 0; i < v.NumField(); i++ {
+	for _, i := range fields {
 		field := v.Field(i)
 		fieldType := v.Type().Field(i)
 
-		if !fieldType.IsExported() {
+		if hasTagOption(fieldType, "omitempty") && isEmptyLeaf(field) {
+			values = append(values, nil)
 			continue
 		}
 
-		if converter, ok := TypeConverters[field.Type()]; ok {
+		if isJSONField(fieldType) {
+			value, err := jsonCell(field)
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, value)
+			continue
+		}
+
+		enum, err := enumFor(fieldType)
+		if err != nil {
+			return nil, err
+		}
+		if enum != nil {
+			if field.Kind() == reflect.Ptr && field.IsNil() {
+				values = append(values, nil)
+			} else {
+				values = append(values, enum.label(reflect.Indirect(field).Interface()))
+			}
+			continue
+		}
+
+		if converter, ok := converterFor(field.Type()); ok {
 			converted, err := converter(field.Interface())
 			if err != nil {
-				return nil, fmt.Errorf("error converting custom type: %v", err)
+				return nil, fmt.Errorf("error converting custom type: %w", err)
 			}
 			values = append(values, converted)
 			continue
@@ -118,6 +149,23 @@ func getFirstSliceValue(slice reflect.Value) ([]interface{}, error) {
 	return []interface{}{""}, nil
 }
 
+// isEmptyLeaf reports whether a field written to a single cell holds its zero value or a nil pointer.
+// Nested structs are never empty, since their fields have columns of their own.
+func isEmptyLeaf(v reflect.Value) bool {
+	if v.Kind() == reflect.Ptr {
+		return v.IsNil() && !isNestedStruct(v.Type().Elem())
+	}
+	return !isNestedStruct(v.Type()) && v.Kind() != reflect.Slice && v.IsZero()
+}
+
+// isNestedStruct reports whether values of type t are flattened into columns of their own
+func isNestedStruct(t reflect.Type) bool {
+	if _, ok := converterFor(t); ok {
+		return false
+	}
+	return t.Kind() == reflect.Struct && t != reflect.TypeOf(time.Time{})
+}
+
 func getDefaultValue(t reflect.Type) interface{} {
 	switch t.Kind() {
 	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
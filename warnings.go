@@ -0,0 +1,130 @@
+package xlsx_utilities
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ImportWarning reports a cell value that was imported after a recoverable adjustment,
+// such as trimmed white space, so the row is kept but the user can still be told
+type ImportWarning struct {
+	RowIndex int
+	Header   string
+	Column   int
+	Cell     string
+	Value    interface{}
+	Message  string
+}
+
+// String returns a string representation of the ImportWarning
+func (w ImportWarning) String() string {
+	return fmt.Sprintf("Row %d, Column '%s': %s", w.RowIndex, w.Header, w.Message)
+}
+
+// adjusted is returned by setField when the value was set after an adjustment that is reported as a warning
+type adjusted struct {
+	message string
+}
+
+func (a *adjusted) Error() string {
+	return a.message
+}
+
+// adjustment returns the adjusted error for a non-empty message, and nil otherwise
+func adjustment(message string) error {
+	if message == "" {
+		return nil
+	}
+	return &adjusted{message: message}
+}
+
+// timeFallbackLayouts are tried in order when a time value is not RFC 3339,
+// covering plain dates and the formats Excel displays dates with
+var timeFallbackLayouts = []string{
+	time.DateTime,
+	time.DateOnly,
+	"1/2/06 15:04",
+	"01-02-06",
+	"1/2/2006",
+}
+
+// parseIntLenient parses an integer, trimming white space or truncating a fraction when needed.
+// The message describes the adjustment made, if any.
+func parseIntLenient(text string) (int64, string, error) {
+	n, err := strconv.ParseInt(text, 10, 64)
+	if err == nil {
+		return n, "", nil
+	}
+
+	trimmed := strings.TrimSpace(text)
+	if n, err := strconv.ParseInt(trimmed, 10, 64); err == nil {
+		return n, "whitespace trimmed", nil
+	}
+	if f, ferr := strconv.ParseFloat(trimmed, 64); ferr == nil && f >= math.MinInt64 && f < math.MaxInt64 {
+		return int64(f), fmt.Sprintf("value truncated to %d", int64(f)), nil
+	}
+	return 0, "", err
+}
+
+// parseUintLenient parses an unsigned integer like parseIntLenient
+func parseUintLenient(text string) (uint64, string, error) {
+	n, err := strconv.ParseUint(text, 10, 64)
+	if err == nil {
+		return n, "", nil
+	}
+
+	trimmed := strings.TrimSpace(text)
+	if n, err := strconv.ParseUint(trimmed, 10, 64); err == nil {
+		return n, "whitespace trimmed", nil
+	}
+	if f, ferr := strconv.ParseFloat(trimmed, 64); ferr == nil && f >= 0 && f < math.MaxUint64 {
+		return uint64(f), fmt.Sprintf("value truncated to %d", uint64(f)), nil
+	}
+	return 0, "", err
+}
+
+// parseFloatLenient parses a float, trimming white space when needed
+func parseFloatLenient(text string) (float64, string, error) {
+	f, err := strconv.ParseFloat(text, 64)
+	if err == nil {
+		return f, "", nil
+	}
+	if f, err := strconv.ParseFloat(strings.TrimSpace(text), 64); err == nil {
+		return f, "whitespace trimmed", nil
+	}
+	return 0, "", err
+}
+
+// parseBoolLenient parses a bool, trimming white space when needed
+func parseBoolLenient(text string) (bool, string, error) {
+	b, err := strconv.ParseBool(text)
+	if err == nil {
+		return b, "", nil
+	}
+	if b, err := strconv.ParseBool(strings.TrimSpace(text)); err == nil {
+		return b, "whitespace trimmed", nil
+	}
+	return false, "", err
+}
+
+// parseTimeLenient parses an RFC 3339 time, falling back to common date layouts
+func parseTimeLenient(text string) (time.Time, string, error) {
+	t, err := time.Parse(time.RFC3339, text)
+	if err == nil {
+		return t, "", nil
+	}
+
+	trimmed := strings.TrimSpace(text)
+	for _, layout := range append([]string{time.RFC3339}, timeFallbackLayouts...) {
+		if t, ferr := time.Parse(layout, trimmed); ferr == nil {
+			if layout == time.RFC3339 {
+				return t, "whitespace trimmed", nil
+			}
+			return t, fmt.Sprintf("date parsed with layout %q", layout), nil
+		}
+	}
+	return time.Time{}, "", err
+}
@@ -0,0 +1,46 @@
+package xlsx_utilities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCellWarnings(t *testing.T) {
+	type employee struct {
+		Name   string
+		Age    int
+		Rate   float64
+		Active bool
+		Joined time.Time
+	}
+
+	excelData := NewExcelData[employee]([]string{"Name", "Age", "Rate", "Active", "Joined"})
+	excelData.AddRow([]interface{}{"Alice", "30", "1.5", "true", "2024-03-01T00:00:00Z"})
+	excelData.AddRow([]interface{}{"Bob", " 42 ", "2.5 ", " TRUE", "3/1/24 09:30"})
+	excelData.AddRow([]interface{}{"Carol", "2.7", "x", "false", "2024-03-01"})
+
+	result := excelData.ToStruct()
+	assert.Empty(t, result.Errors)
+	assert.Equal(t, []employee{
+		{"Alice", 30, 1.5, true, time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)},
+		{"Bob", 42, 2.5, true, time.Date(2024, 3, 1, 9, 30, 0, 0, time.UTC)},
+		{"Carol", 2, 0, false, time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)},
+	}, result.Data)
+
+	var messages []string
+	for _, w := range result.CellWarnings {
+		messages = append(messages, w.String())
+	}
+	assert.Equal(t, []string{
+		"Row 3, Column 'Age': whitespace trimmed",
+		"Row 3, Column 'Rate': whitespace trimmed",
+		"Row 3, Column 'Active': whitespace trimmed",
+		`Row 3, Column 'Joined': date parsed with layout "1/2/06 15:04"`,
+		"Row 4, Column 'Age': value truncated to 2",
+		`Row 4, Column 'Joined': date parsed with layout "2006-01-02"`,
+	}, messages)
+	assert.Equal(t, "B3", result.CellWarnings[0].Cell)
+	assert.Equal(t, " 42 ", result.CellWarnings[0].Value)
+}
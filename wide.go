@@ -0,0 +1,176 @@
+package xlsx_utilities
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// WidePolicy decides what happens when the exported columns do not fit in a sheet,
+// which holds at most 16,384 columns counted from column A
+type WidePolicy int
+
+const (
+	// WideError fails the export, listing the headers that do not fit
+	WideError WidePolicy = iota
+	// WideSpill writes the columns that do not fit to additional sheets named "<sheet> (2)", "<sheet> (3)", ...
+	// Only the first sheet gets tables, pivot tables, charts and merged cells.
+	WideSpill
+	// WideJSON collapses the largest nested structs into a single JSON cell each,
+	// keyed by the rest of the header, until the columns fit
+	WideJSON
+)
+
+// WithWidePolicy sets how exports wider than a sheet are handled; the default is WideError
+func WithWidePolicy(policy WidePolicy) Option {
+	return func(c *config) {
+		c.widePolicy = policy
+	}
+}
+
+// maxOverflowHeaders is the number of overflowing headers listed in a WideError error
+const maxOverflowHeaders = 10
+
+// fitColumns returns the parts of the data to write to consecutive sheets, so each fits in limit columns
+func (ed *ExcelData[T]) fitColumns(cfg *config, limit int) ([]*ExcelData[T], error) {
+	if len(ed.Headers) <= limit {
+		return []*ExcelData[T]{ed}, nil
+	}
+
+	switch cfg.widePolicy {
+	case WideSpill:
+		return ed.spillColumns(limit), nil
+	case WideJSON:
+		collapsed, err := ed.collapseColumns(limit)
+		if err != nil {
+			return nil, err
+		}
+		return []*ExcelData[T]{collapsed}, nil
+	default:
+		overflow := ed.Headers[limit:]
+		listed := overflow
+		if len(listed) > maxOverflowHeaders {
+			listed = listed[:maxOverflowHeaders]
+		}
+		msg := strings.Join(listed, ", ")
+		if len(overflow) > len(listed) {
+			msg += fmt.Sprintf(" and %d more", len(overflow)-len(listed))
+		}
+		return nil, fmt.Errorf("too many columns (%d, the sheet fits %d): %s", len(ed.Headers), limit, msg)
+	}
+}
+
+// spillColumns splits the columns into consecutive blocks of at most limit columns
+func (ed *ExcelData[T]) spillColumns(limit int) []*ExcelData[T] {
+	var parts []*ExcelData[T]
+	for start := 0; start < len(ed.Headers); start += limit {
+		end := start + limit
+		if end > len(ed.Headers) {
+			end = len(ed.Headers)
+		}
+
+		rows := make([][]interface{}, len(ed.Rows))
+		for r, row := range ed.Rows {
+			if start < len(row) {
+				rows[r] = row[start:min(end, len(row))]
+			}
+		}
+
+		part := ed.view(rows)
+		part.Headers = ed.Headers[start:end]
+		if len(ed.Provenance) > 0 {
+			part.Provenance = make([][]Provenance, len(ed.Rows))
+			for r := range ed.Rows {
+				for col := start; col < end; col++ {
+					part.Provenance[r] = append(part.Provenance[r], ed.provenanceAt(r, col))
+				}
+			}
+		}
+		parts = append(parts, part)
+	}
+	return parts
+}
+
+// collapseColumns replaces the columns of the largest nested structs by a JSON column each until they fit
+func (ed *ExcelData[T]) collapseColumns(limit int) (*ExcelData[T], error) {
+	// group the columns by their first header word, i.e. the top-level field
+	branches := make(map[string][]int)
+	var names []string
+	for i, header := range ed.Headers {
+		name, _, _ := strings.Cut(header, " ")
+		if _, ok := branches[name]; !ok {
+			names = append(names, name)
+		}
+		branches[name] = append(branches[name], i)
+	}
+	sort.SliceStable(names, func(i, j int) bool {
+		return len(branches[names[i]]) > len(branches[names[j]])
+	})
+
+	collapsed := make(map[string]bool)
+	width := len(ed.Headers)
+	for _, name := range names {
+		if width <= limit || len(branches[name]) < 2 {
+			break
+		}
+		collapsed[name] = true
+		width -= len(branches[name]) - 1
+	}
+	if width > limit {
+		return nil, fmt.Errorf("too many columns (%d, the sheet fits %d) even with nested structs as JSON", width, limit)
+	}
+
+	var headers []string
+	emitted := make(map[string]bool)
+	for _, header := range ed.Headers {
+		name, _, _ := strings.Cut(header, " ")
+		if !collapsed[name] {
+			headers = append(headers, header)
+		} else if !emitted[name] {
+			emitted[name] = true
+			headers = append(headers, name)
+		}
+	}
+
+	rows := make([][]interface{}, len(ed.Rows))
+	for r, row := range ed.Rows {
+		objects := make(map[string]map[string]interface{})
+		for i, header := range ed.Headers {
+			name, rest, _ := strings.Cut(header, " ")
+			if !collapsed[name] {
+				continue
+			}
+			if objects[name] == nil {
+				objects[name] = make(map[string]interface{})
+			}
+			if i < len(row) {
+				objects[name][rest] = row[i]
+			}
+		}
+
+		emitted := make(map[string]bool)
+		for i, header := range ed.Headers {
+			name, _, _ := strings.Cut(header, " ")
+			switch {
+			case !collapsed[name]:
+				var value interface{}
+				if i < len(row) {
+					value = row[i]
+				}
+				rows[r] = append(rows[r], value)
+			case !emitted[name]:
+				emitted[name] = true
+				text, err := json.Marshal(objects[name])
+				if err != nil {
+					return nil, fmt.Errorf("error encoding '%s' as JSON: %w", name, err)
+				}
+				rows[r] = append(rows[r], string(text))
+			}
+		}
+	}
+
+	result := ed.view(rows)
+	result.Headers = headers
+	return result, nil
+}
@@ -0,0 +1,68 @@
+package xlsx_utilities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWidePolicy(t *testing.T) {
+	type address struct {
+		Street string
+		City   string
+		Zip    string
+	}
+
+	type customer struct {
+		Name    string
+		Email   string
+		Address address
+	}
+
+	data := []customer{{Name: "Alice", Email: "a@example.com", Address: address{Street: "Main 1", City: "Jakarta", Zip: "10110"}}}
+	excelData, err := FromStruct(data)
+	assert.NoError(t, err)
+
+	// Anchored at XFA1, only the last 4 columns of the sheet are available for the 5 headers
+	anchor := WithAnchor("XFA1")
+
+	t.Run("Error by default", func(t *testing.T) {
+		_, err := excelData.ToWorkbook(anchor)
+		assert.Error(t, err)
+		assert.Equal(t, "too many columns (5, the sheet fits 4): Address Zip", err.Error())
+	})
+
+	t.Run("Spill to additional sheets", func(t *testing.T) {
+		f, err := excelData.ToWorkbook(anchor, WithWidePolicy(WideSpill))
+		assert.NoError(t, err)
+		defer f.Close()
+
+		value, _ := f.GetCellValue("Sheet1", "XFD2")
+		assert.Equal(t, "Jakarta", value)
+		value, _ = f.GetCellValue("Sheet1 (2)", "XFA1")
+		assert.Equal(t, "Address Zip", value)
+		value, _ = f.GetCellValue("Sheet1 (2)", "XFA2")
+		assert.Equal(t, "10110", value)
+	})
+
+	t.Run("Spill from a long sheet name", func(t *testing.T) {
+		name := "Quarterly Customer Addresses EU"
+		f, err := excelData.ToWorkbook(anchor, WithSheet(name), WithWidePolicy(WideSpill))
+		assert.NoError(t, err)
+		defer f.Close()
+
+		value, _ := f.GetCellValue("Quarterly Customer Addresse (2)", "XFA2")
+		assert.Equal(t, "10110", value)
+	})
+
+	t.Run("Collapse nested structs to JSON", func(t *testing.T) {
+		f, err := excelData.ToWorkbook(anchor, WithWidePolicy(WideJSON))
+		assert.NoError(t, err)
+		defer f.Close()
+
+		rows, err := f.GetRows("Sheet1")
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"Name", "Email", "Address"}, rows[0][len(rows[0])-3:])
+		assert.Equal(t, `{"City":"Jakarta","Street":"Main 1","Zip":"10110"}`, rows[1][len(rows[1])-1])
+	})
+}
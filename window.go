@@ -0,0 +1,63 @@
+package xlsx_utilities
+
+import "github.com/xuri/excelize/v2"
+
+// WithOffset skips the first n data rows when reading, e.g. to page through a large upload.
+// Row numbers in import errors still refer to the sheet.
+func WithOffset(n int) Option {
+	return func(c *config) {
+		c.offset = max(n, 0)
+	}
+}
+
+// WithLimit reads at most n data rows; zero or less reads all rows. Without transposition or
+// continuation rows, the sheet is only parsed up to the last row needed, so previews of huge files stay cheap.
+func WithLimit(n int) Option {
+	return func(c *config) {
+		c.limit = n
+	}
+}
+
+// readRowsPrefix reads at most n rows from the top of the sheet, stopping the sheet parse there
+func readRowsPrefix(f *excelize.File, cfg *config, n int) ([][]string, error) {
+	iter, err := f.Rows(cfg.sheet)
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	var rows [][]string
+	for len(rows) < n && iter.Next() {
+		row, err := iter.Columns(excelize.Options{RawCellValue: cfg.rawValues})
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, row)
+	}
+	if err := iter.Error(); err != nil {
+		return nil, err
+	}
+	return trimEmptyRows(rows), nil
+}
+
+// trimEmptyRows drops trailing rows without content, as GetRows does
+func trimEmptyRows(rows [][]string) [][]string {
+	for len(rows) > 0 && len(trimRows(rows[len(rows)-1:])[0]) == 0 {
+		rows = rows[:len(rows)-1]
+	}
+	return rows
+}
+
+// windowRows keeps the header row and the data rows selected by the offset and limit
+func windowRows[E any](rows []E, offset, limit int) []E {
+	if len(rows) == 0 || offset == 0 && limit <= 0 {
+		return rows
+	}
+
+	data := rows[1:]
+	data = data[min(offset, len(data)):]
+	if limit > 0 && limit < len(data) {
+		data = data[:limit]
+	}
+	return append([]E{rows[0]}, data...)
+}
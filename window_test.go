@@ -0,0 +1,58 @@
+package xlsx_utilities
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWindowedRead(t *testing.T) {
+	filename := "test_window.xlsx"
+	defer os.Remove(filename)
+
+	excelData := NewExcelData[person]([]string{"Name", "Age"})
+	for i := 1; i <= 10; i++ {
+		excelData.AddRow([]interface{}{fmt.Sprintf("P%d", i), i})
+	}
+	assert.NoError(t, excelData.SetProvenance(5, "Age", ProvenanceDefaulted))
+	assert.NoError(t, excelData.Save(filename, WithProvenance()))
+
+	t.Run("Preview", func(t *testing.T) {
+		imported, err := FromExcel[person](filename, WithLimit(3))
+		assert.NoError(t, err)
+		assert.Equal(t, [][]interface{}{{"P1", 1}, {"P2", 2}, {"P3", 3}}, imported.Rows)
+	})
+
+	t.Run("Page", func(t *testing.T) {
+		rejectP7 := WithAfterReadRow(func(rowIndex int, p *person) error {
+			if p.Name == "P7" {
+				return fmt.Errorf("rejected")
+			}
+			return nil
+		})
+		imported, err := FromExcel[person](filename, WithOffset(5), WithLimit(3), WithProvenance(), rejectP7)
+		assert.NoError(t, err)
+		assert.Equal(t, [][]interface{}{{"P6", 6}, {"P7", 7}, {"P8", 8}}, imported.Rows)
+		assert.Equal(t, ProvenanceDefaulted, imported.ProvenanceOf(0, "Age"))
+
+		// row numbers refer to the sheet: P7 is on row 8
+		result := imported.ToStruct()
+		assert.Len(t, result.Data, 2)
+		assert.Equal(t, 8, result.Errors[0].RowIndex)
+	})
+
+	t.Run("Offset only", func(t *testing.T) {
+		imported, err := FromExcel[person](filename, WithOffset(8))
+		assert.NoError(t, err)
+		assert.Equal(t, [][]interface{}{{"P9", 9}, {"P10", 10}}, imported.Rows)
+	})
+
+	t.Run("Past the end", func(t *testing.T) {
+		imported, err := FromExcel[person](filename, WithOffset(20), WithLimit(5))
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"Name", "Age"}, imported.Headers)
+		assert.Empty(t, imported.Rows)
+	})
+}
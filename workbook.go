@@ -0,0 +1,147 @@
+package xlsx_utilities
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// sheetSelector picks the sheet to read among the visible sheets of a workbook
+type sheetSelector struct {
+	description string
+	match       func(index int, name string) bool
+}
+
+// WithSheetIndex reads the visible sheet at the 0-based index, skipping hidden helper sheets
+func WithSheetIndex(index int) Option {
+	return func(c *config) {
+		c.selector = &sheetSelector{
+			description: fmt.Sprintf("index %d", index),
+			match:       func(i int, _ string) bool { return i == index },
+		}
+	}
+}
+
+// WithSheetPattern reads the first visible sheet whose name matches the pattern.
+// With FromExcelAllSheets, only the matching visible sheets are read.
+func WithSheetPattern(pattern *regexp.Regexp) Option {
+	return func(c *config) {
+		c.selector = &sheetSelector{
+			description: fmt.Sprintf("pattern '%s'", pattern),
+			match:       func(_ int, name string) bool { return pattern.MatchString(name) },
+		}
+	}
+}
+
+// visibleSheets returns the visible sheets of the workbook, without the sheets written by the library itself
+func visibleSheets(f *excelize.File) ([]string, error) {
+	sheets := f.GetSheetList()
+
+	var visible []string
+	for _, sheet := range sheets {
+		if isMetadataSheet(sheet, sheets) {
+			continue
+		}
+		ok, err := f.GetSheetVisible(sheet)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			visible = append(visible, sheet)
+		}
+	}
+	return visible, nil
+}
+
+// selectSheet resolves the sheet selector of the config to a sheet name
+func selectSheet(f *excelize.File, cfg *config) (*config, error) {
+	if cfg.selector == nil {
+		return cfg, nil
+	}
+
+	sheets, err := visibleSheets(f)
+	if err != nil {
+		return nil, err
+	}
+	for i, sheet := range sheets {
+		if cfg.selector.match(i, sheet) {
+			selected := *cfg
+			selected.sheet = sheet
+			selected.selector = nil
+			return &selected, nil
+		}
+	}
+
+	return nil, fmt.Errorf("no visible sheet matches %s", cfg.selector.description)
+}
+
+// FromExcelAllSheets reads every sheet of a workbook, for workbooks keeping the same columns on one tab per month or region.
+// The result is keyed by sheet name; sheets written by the library itself, such as the schema and provenance sheets
+// and the reference sheet set with WithReferenceSheet, are skipped, as are sheets without data rows.
+// It returns ErrEmptyFile when no sheet has data rows.
+func FromExcelAllSheets[T comparable](filename string, opts ...Option) (map[string]*ExcelData[T], error) {
+	f, err := openExcelFile(filename, newConfig(opts))
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return fromAllSheets[T](f, opts)
+}
+
+// fromAllSheets reads each data sheet of the workbook with the given options
+func fromAllSheets[T comparable](f *excelize.File, opts []Option) (map[string]*ExcelData[T], error) {
+	all := f.GetSheetList()
+	sheets := all
+	cfg := newConfig(opts)
+	selector := cfg.selector
+	if selector != nil {
+		visible, err := visibleSheets(f)
+		if err != nil {
+			return nil, err
+		}
+		sheets = nil
+		for i, sheet := range visible {
+			if selector.match(i, sheet) {
+				sheets = append(sheets, sheet)
+			}
+		}
+	}
+
+	result := make(map[string]*ExcelData[T])
+	for _, sheet := range sheets {
+		if isMetadataSheet(sheet, all) || sheet == cfg.referenceSheet {
+			continue
+		}
+
+		sheetOpts := append(append([]Option(nil), opts...), WithSheet(sheet))
+		ed, err := fromWorkbook[T](f, sheetOpts)
+		if errors.Is(err, ErrEmptyFile) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("sheet '%s': %w", sheet, err)
+		}
+		result[sheet] = ed
+	}
+	if len(result) == 0 {
+		return nil, ErrEmptyFile
+	}
+
+	return result, nil
+}
+
+// isMetadataSheet reports whether the sheet holds the schema, the import errors or the provenance of another sheet
+func isMetadataSheet(sheet string, sheets []string) bool {
+	if sheet == SchemaSheet || sheet == ImportErrorsSheet {
+		return true
+	}
+	for _, other := range sheets {
+		if other != sheet && provenanceSheet(other) == sheet {
+			return true
+		}
+	}
+	return false
+}
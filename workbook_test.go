@@ -0,0 +1,119 @@
+package xlsx_utilities
+
+import (
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/xuri/excelize/v2"
+)
+
+func TestFromExcelAllSheets(t *testing.T) {
+	filename := "test_all_sheets.xlsx"
+	defer os.Remove(filename)
+
+	january, err := FromStruct([]person{{Name: "Alice", Age: 30}})
+	assert.NoError(t, err)
+	february, err := FromStruct([]person{{Name: "Bob", Age: 25}, {Name: "Carol", Age: 41}})
+	assert.NoError(t, err)
+
+	f, err := january.ToWorkbook(WithSheet("January"), WithSchema(), WithProvenance())
+	assert.NoError(t, err)
+	assert.NoError(t, february.AddToWorkbook(f, WithSheet("February")))
+	assert.NoError(t, f.SaveAs(filename))
+	f.Close()
+
+	t.Run("One dataset per sheet", func(t *testing.T) {
+		sheets, err := FromExcelAllSheets[person](filename)
+		assert.NoError(t, err)
+		assert.Len(t, sheets, 2)
+
+		result := sheets["January"].ToStruct()
+		assert.Equal(t, []person{{Name: "Alice", Age: 30}}, result.Data)
+
+		result = sheets["February"].ToStruct()
+		assert.Equal(t, []person{{Name: "Bob", Age: 25}, {Name: "Carol", Age: 41}}, result.Data)
+	})
+
+	t.Run("Empty sheet", func(t *testing.T) {
+		f, err := excelize.OpenFile(filename)
+		assert.NoError(t, err)
+		defer f.Close()
+		_, err = f.NewSheet("Notes")
+		assert.NoError(t, err)
+
+		sheets, err := fromAllSheets[person](f, nil)
+		assert.NoError(t, err)
+		assert.Len(t, sheets, 2)
+		assert.NotContains(t, sheets, "Notes")
+	})
+
+	t.Run("Reference sheet", func(t *testing.T) {
+		type ticket struct {
+			Name   string
+			Status int `xlsx:"Status,map=1:Active;2:Inactive"`
+		}
+		referenced := "test_all_sheets_reference.xlsx"
+		defer os.Remove(referenced)
+
+		tickets, err := FromStruct([]ticket{{Name: "Login", Status: 1}})
+		assert.NoError(t, err)
+		assert.NoError(t, tickets.Save(referenced, WithReferenceSheet("Codes")))
+
+		sheets, err := FromExcelAllSheets[ticket](referenced, WithReferenceSheet("Codes"))
+		assert.NoError(t, err)
+		assert.Len(t, sheets, 1)
+		assert.Equal(t, []ticket{{Name: "Login", Status: 1}}, sheets["Sheet1"].ToStruct().Data)
+	})
+}
+
+func TestSheetSelection(t *testing.T) {
+	filename := "test_sheet_selection.xlsx"
+	defer os.Remove(filename)
+
+	lookup, err := FromStruct([]person{{Name: "Lookup", Age: 0}})
+	assert.NoError(t, err)
+	data, err := FromStruct([]person{{Name: "Alice", Age: 30}})
+	assert.NoError(t, err)
+	june, err := FromStruct([]person{{Name: "Bob", Age: 25}})
+	assert.NoError(t, err)
+
+	f, err := data.ToWorkbook(WithSheet("Export 2024-05"))
+	assert.NoError(t, err)
+	assert.NoError(t, lookup.AddToWorkbook(f, WithSheet("Helper"), WithSheetVisibility(SheetHidden)))
+	assert.NoError(t, june.AddToWorkbook(f, WithSheet("Export 2024-06")))
+	assert.NoError(t, f.SaveAs(filename))
+	f.Close()
+
+	t.Run("Index skips hidden sheets", func(t *testing.T) {
+		imported, err := FromExcel[person](filename, WithSheetIndex(1))
+		assert.NoError(t, err)
+		assert.Equal(t, []interface{}{"Bob", 25}, imported.Rows[0])
+	})
+
+	t.Run("Pattern", func(t *testing.T) {
+		imported, err := FromExcel[person](filename, WithSheetPattern(regexp.MustCompile(`^Export`)))
+		assert.NoError(t, err)
+		assert.Equal(t, []interface{}{"Alice", 30}, imported.Rows[0])
+
+		sheets, err := FromExcelAllSheets[person](filename, WithSheetPattern(regexp.MustCompile(`^Export`)))
+		assert.NoError(t, err)
+		assert.Len(t, sheets, 2)
+		assert.Contains(t, sheets, "Export 2024-06")
+	})
+
+	t.Run("No match", func(t *testing.T) {
+		_, err := FromExcel[person](filename, WithSheetIndex(2))
+		assert.EqualError(t, err, "no visible sheet matches index 2")
+
+		_, err = FromExcel[person](filename, WithSheetPattern(regexp.MustCompile(`^Report`)))
+		assert.EqualError(t, err, "no visible sheet matches pattern '^Report'")
+	})
+
+	t.Run("WithSheet overrides a selector", func(t *testing.T) {
+		imported, err := FromExcel[person](filename, WithSheetIndex(1), WithSheet("Helper"))
+		assert.NoError(t, err)
+		assert.Equal(t, []interface{}{"Lookup", 0}, imported.Rows[0])
+	})
+}